@@ -0,0 +1,231 @@
+// Package cli admin subcommands of the main binary, run through same usecases as HTTP handlers
+// directly against configured storage.
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	UsecaseFloor "github.com/mhaikalla/parking-service-management-library/components/usecase/usecaseFloor"
+	UsecaseParking "github.com/mhaikalla/parking-service-management-library/components/usecase/usecaseParking"
+	UsecaseParkingLot "github.com/mhaikalla/parking-service-management-library/components/usecase/usecaseParkingLot"
+	UsecaseSite "github.com/mhaikalla/parking-service-management-library/components/usecase/usecaseSite"
+	UsecaseVehicle "github.com/mhaikalla/parking-service-management-library/components/usecase/usecaseVehicle"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+	"github.com/mhaikalla/parking-service-management-library/pkg/jwt"
+
+	validation "github.com/go-playground/validator/v10"
+)
+
+// Output format of command result.
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+)
+
+// ActorPrefix prefix of audit trail actor of change made by command, followed by OS user, eg: `cli:alice`.
+const ActorPrefix = "cli:"
+
+// ErrUsage returned when command or its flags invalid, usage already written.
+var ErrUsage = errors.New("invalid usage")
+
+// action subcommand of a resource, eg: `create` of `lot`.
+type action struct {
+	usage string
+	run   func(a *App, c *call) error
+}
+
+// resources every command as `<resource> <action>`.
+var resources = map[string]map[string]action{
+	"lot":     lotActions,
+	"vehicle": vehicleActions,
+	"session": sessionActions,
+	"report":  reportActions,
+}
+
+// App run admin commands against storage of config.
+type App struct {
+	Validator validation.Validate
+	// In read by console when no command file given.
+	In  io.Reader
+	Out io.Writer
+	Err io.Writer
+	// Actor recorded on audit trail as author of change.
+	Actor string
+
+	parking    UsecaseParking.IUsecaseParking
+	parkingLot UsecaseParkingLot.IUsecaseParkingLot
+	floor      UsecaseFloor.IUsecaseFloor
+	vehicle    UsecaseVehicle.IUsecaseVehicle
+	site       UsecaseSite.IUsecaseSite
+}
+
+// NewApp app using storage and queue of `config`, result written on stdout.
+// Safe to run while server serving same storage, tables locked across process while written.
+func NewApp(config *config.Config, validator validation.Validate) *App {
+	path := config.FileStorage.Path
+	return &App{
+		Validator: validator,
+		In:        os.Stdin,
+		Out:       os.Stdout,
+		Err:       os.Stderr,
+		Actor:     ActorPrefix + osUser(),
+		parking: UsecaseParking.NewParkingUsecase(
+			file.NewFileSystem(path),
+			audit.ForStorage(path),
+			UsecaseParking.NewQueueConfig(config.Parking.Queue),
+		),
+		parkingLot: UsecaseParkingLot.NewParkingLotUsecase(file.NewFileSystem(path), audit.ForStorage(path)),
+		floor:      UsecaseFloor.NewFloorUsecase(file.NewFileSystem(path), audit.ForStorage(path)),
+		vehicle:    UsecaseVehicle.NewVehicleUsecase(file.NewFileSystem(path), audit.ForStorage(path)),
+		site:       UsecaseSite.NewSiteUsecase(file.NewFileSystem(path), audit.ForStorage(path)),
+	}
+}
+
+// Usage write every command with its flags.
+func Usage(w io.Writer) {
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintln(w, "Commands:")
+	fmt.Fprintln(w, "  serve                 serve HTTP API (default)")
+	fmt.Fprintln(w, "  console               run console commands from stdin or -file FILE [-site ID]")
+	for _, name := range names {
+		actions := make([]string, 0, len(resources[name]))
+		for act := range resources[name] {
+			actions = append(actions, act)
+		}
+		sort.Strings(actions)
+		for _, act := range actions {
+			fmt.Fprintf(w, "  %-21s %s\n", name+" "+act, resources[name][act].usage)
+		}
+	}
+	fmt.Fprintln(w, "Every command accept -site ID (default site 0) and -o table|json, see `<command> -h`.")
+	consoleUsage(w)
+}
+
+// Run run command `args`, eg: `lot create -name A1 -floor 1`.
+func (a *App) Run(args []string) error {
+	if len(args) > 0 && args[0] == ConsoleCommand {
+		return a.console(args[1:])
+	}
+	if len(args) < 2 {
+		Usage(a.Err)
+		return ErrUsage
+	}
+	act, ok := resources[args[0]][args[1]]
+	if !ok {
+		fmt.Fprintf(a.Err, "unknown command %q\n", strings.Join(args[:2], " "))
+		Usage(a.Err)
+		return ErrUsage
+	}
+
+	c := &call{
+		name:  args[0] + " " + args[1],
+		flags: flag.NewFlagSet(args[0]+" "+args[1], flag.ContinueOnError),
+	}
+	c.flags.SetOutput(a.Err)
+	c.flags.IntVar(&c.site, "site", 0, "site ID, 0 for default site")
+	c.flags.StringVar(&c.output, "o", OutputTable, "output format: table | json")
+	c.args = args[2:]
+	return act.run(a, c)
+}
+
+// call command being run with its flags.
+type call struct {
+	name   string
+	flags  *flag.FlagSet
+	args   []string
+	site   int
+	output string
+}
+
+// parse parse flags declared by action, usage error when invalid, flag.ErrHelp when help asked.
+func (c *call) parse() error {
+	if err := c.flags.Parse(c.args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return ErrUsage
+	}
+	if c.flags.NArg() > 0 {
+		fmt.Fprintf(c.flags.Output(), "unexpected argument %q\n", c.flags.Arg(0))
+		return ErrUsage
+	}
+	if c.output != OutputTable && c.output != OutputJSON {
+		fmt.Fprintf(c.flags.Output(), "unknown output %q, want table or json\n", c.output)
+		return ErrUsage
+	}
+	return nil
+}
+
+// context caller of usecase, admin of site acting as OS user.
+func (a *App) context(c *call) contexts.BearerContext {
+	return contexts.BearerContext{SideLoad: contexts.SideLoad{BearerData: jwt.JWTClaims{
+		Subject: a.Actor,
+		SiteID:  c.site,
+		Roles:   []string{constant.RoleAdmin},
+	}}}
+}
+
+// validate validate request as handlers do, every invalid field reported.
+func (a *App) validate(req interface{}) error {
+	err := a.Validator.Struct(req)
+	fieldErrs, ok := err.(validation.ValidationErrors)
+	if !ok {
+		return err
+	}
+	problems := make([]string, 0, len(fieldErrs))
+	for _, fe := range fieldErrs {
+		problems = append(problems, fe.Field()+" "+fe.Tag())
+	}
+	return fmt.Errorf("invalid request: %s", strings.Join(problems, ", "))
+}
+
+// write write `result` as JSON, or as table of `header` and `rows`.
+func (a *App) write(c *call, result interface{}, header []string, rows [][]string) error {
+	if c.output == OutputJSON {
+		enc := json.NewEncoder(a.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+	tw := tabwriter.NewWriter(a.Out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// failed error of usecase, nil when succeed.
+func failed(e *errs.Errs) error {
+	if e == nil {
+		return nil
+	}
+	return fmt.Errorf("%s (code %s)", e.Message, e.Code)
+}
+
+func osUser() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	if name := os.Getenv("USER"); name != "" {
+		return name
+	}
+	return "unknown"
+}
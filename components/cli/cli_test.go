@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"strings"
+
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CommandSuite struct {
+	suite.Suite
+	app *App
+	out *bytes.Buffer
+}
+
+func (s *CommandSuite) SetupTest() {
+	s.app = newTestApp(s.T())
+	s.out = &bytes.Buffer{}
+	s.app.Out, s.app.Err = s.out, s.out
+}
+
+// run run command `args`, its output returned.
+func (s *CommandSuite) run(args ...string) (string, error) {
+	s.out.Reset()
+	err := s.app.Run(args)
+	return s.out.String(), err
+}
+
+// mustRun run command `args` expected to succeed.
+func (s *CommandSuite) mustRun(args ...string) string {
+	out, err := s.run(args...)
+	s.Require().NoError(err, out)
+	return out
+}
+
+func (s *CommandSuite) TestUsage() {
+	_, err := s.run("lot")
+	s.ErrorIs(err, ErrUsage)
+	_, err = s.run("lot", "rename")
+	s.ErrorIs(err, ErrUsage)
+	_, err = s.run("lot", "list", "-o", "xml")
+	s.ErrorIs(err, ErrUsage)
+	_, err = s.run("lot", "list", "extra")
+	s.ErrorIs(err, ErrUsage)
+	_, err = s.run("lot", "list", "-h")
+	s.True(errors.Is(err, flag.ErrHelp))
+
+	_, err = s.run("vehicle", "create", "-name", "SUV")
+	s.EqualError(err, "invalid request: type required, first_hour_price required, price_per_hour_percent required")
+}
+
+func (s *CommandSuite) TestLot() {
+	_, err := s.run("lot", "create", "-name", "A1", "-floor", "1")
+	s.EqualError(err, "Floor Data Not Found (code 404)", "floor never created by lot command")
+
+	_, errResp := s.app.floor.CreateFloor(contexts.BearerContext{}, request.CreateFloorRequest{Name: "1", Level: 1})
+	s.Require().Nil(errResp)
+	s.mustRun("lot", "create", "-name", "A1", "-floor", "1")
+	s.mustRun("lot", "create", "-name", "A2", "-floor", "1")
+
+	out := s.mustRun("lot", "list")
+	s.Contains(out, "A1")
+	s.Contains(out, "A2")
+	s.Contains(s.mustRun("lot", "list", "-o", "json"), `"name": "A1"`)
+
+	s.mustRun("lot", "delete", "-id", "1")
+	out = s.mustRun("lot", "list")
+	s.NotContains(out, "A1")
+	s.Contains(out, "A2")
+
+	out, _ = s.run("lot", "list", "-site", "2")
+	s.NotContains(out, "A2", "lots of other site not listed")
+}
+
+func (s *CommandSuite) TestVehicle() {
+	s.mustRun("vehicle", "create", "-name", "SUV", "-type", "SUV", "-first-hour-price", "5000", "-price-per-hour-percent", "10")
+	s.Contains(s.mustRun("vehicle", "list"), "5000")
+
+	s.mustRun("vehicle", "update", "-id", "1", "-name", "SUV", "-type", "SUV", "-first-hour-price", "6000", "-price-per-hour-percent", "10")
+	out := s.mustRun("vehicle", "list")
+	s.Contains(out, "6000")
+	s.NotContains(out, "5000")
+
+	s.mustRun("vehicle", "delete", "-id", "1")
+	out, _ = s.run("vehicle", "list")
+	s.NotContains(out, "SUV", "deleted vehicle type not listed")
+}
+
+func (s *CommandSuite) TestSessionClose() {
+	s.Require().NoError(s.app.runConsole(contexts.BearerContext{}, strings.Fields("create_tariff SUV 5000 10")))
+	s.Require().NoError(s.app.runConsole(contexts.BearerContext{}, strings.Fields("create_parking_lot 1")))
+	s.Require().NoError(s.app.runConsole(contexts.BearerContext{}, strings.Fields("park B-1 Hitam SUV")))
+
+	s.Contains(s.mustRun("session", "list"), "B-1")
+	out := s.mustRun("session", "close", "-plate", "B-1")
+	s.Contains(out, "B-1")
+	s.Contains(out, "5000")
+	s.NotContains(s.mustRun("session", "list"), "B-1", "closed session no longer parked")
+	s.Contains(s.mustRun("session", "list", "-all"), "B-1")
+
+	_, err := s.run("session", "close", "-plate", "B-1")
+	s.Error(err, "session closed once")
+
+	out = s.mustRun("report", "revenue", "-o", "json")
+	s.Contains(out, `"revenue": 5000`)
+}
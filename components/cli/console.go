@@ -0,0 +1,321 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+)
+
+// ConsoleCommand command running console, eg: `console -file scenario.txt`.
+const ConsoleCommand = "console"
+
+// ConsoleFloor floor created by `create_parking_lot` when no floor given and site has no floor yet.
+const ConsoleFloor = "1"
+
+// consoleAction console command taking `args` words, output written on `out`.
+type consoleAction struct {
+	args int
+	// optional trailing arguments taken after `args`
+	optional int
+	usage    string
+	run      func(a *App, dc contexts.BearerContext, out io.Writer, args []string) error
+}
+
+// consoleActions every console command, output only made of stored data so same script give same output.
+var consoleActions = map[string]consoleAction{
+	"create_floor":       {1, 1, "create_floor NAME [CAPACITY]", consoleCreateFloor},
+	"create_parking_lot": {1, 1, "create_parking_lot COUNT [FLOOR]", consoleCreateParkingLot},
+	"create_tariff":      {3, 0, "create_tariff TYPE FIRST_HOUR_PRICE NEXT_HOUR_PERCENT", consoleCreateTariff},
+	"park":               {3, 0, "park PLATE COLOR TYPE", consolePark},
+	"leave":              {1, 0, "leave PLATE", consoleLeave},
+	"status":             {0, 0, "status", consoleStatus},
+	"plates_for_color":   {1, 0, "plates_for_color COLOR", consolePlatesForColor},
+	"count_type":         {1, 0, "count_type TYPE", consoleCountType},
+}
+
+// console run console commands read line by line from stdin or `-file`, one output block per command.
+// Failed command reported and the next one still run, error returned at end when any of them failed.
+func (a *App) console(args []string) error {
+	flags := flag.NewFlagSet(ConsoleCommand, flag.ContinueOnError)
+	flags.SetOutput(a.Err)
+	site := flags.Int("site", 0, "site ID, 0 for default site")
+	path := flags.String("file", "", "command file, stdin when empty")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return ErrUsage
+	}
+	if flags.NArg() > 0 {
+		fmt.Fprintf(a.Err, "unexpected argument %q\n", flags.Arg(0))
+		return ErrUsage
+	}
+
+	in, prompt := a.In, false
+	if *path != "" {
+		f, err := os.Open(*path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	} else if f, ok := a.In.(*os.File); ok {
+		// prompt only for operator typing on terminal, piped script output kept clean
+		if stat, err := f.Stat(); err == nil && stat.Mode()&os.ModeCharDevice != 0 {
+			prompt = true
+		}
+	}
+
+	dc := a.context(&call{site: *site})
+	scanner := bufio.NewScanner(in)
+	total, failures := 0, 0
+	for {
+		if prompt {
+			fmt.Fprint(a.Err, "> ")
+		}
+		if !scanner.Scan() {
+			break
+		}
+		words := strings.Fields(scanner.Text())
+		if len(words) == 0 || strings.HasPrefix(words[0], "#") {
+			continue
+		}
+		if words[0] == "exit" {
+			break
+		}
+		total++
+		if err := a.runConsole(dc, words); err != nil {
+			failures++
+			fmt.Fprintf(a.Out, "Error: %s\n", err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if failures > 0 {
+		return fmt.Errorf("%d of %d console commands failed", failures, total)
+	}
+	return nil
+}
+
+// runConsole run console command `words`, command name followed by its arguments.
+func (a *App) runConsole(dc contexts.BearerContext, words []string) error {
+	act, ok := consoleActions[words[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %q", words[0])
+	}
+	args := words[1:]
+	if len(args) < act.args || len(args) > act.args+act.optional {
+		return fmt.Errorf("usage: %s", act.usage)
+	}
+	return act.run(a, dc, a.Out, args)
+}
+
+// consoleUsage write every console command.
+func consoleUsage(w io.Writer) {
+	names := make([]string, 0, len(consoleActions))
+	for name := range consoleActions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintln(w, "Console commands, one per line, `#` comment, `exit` stop:")
+	for _, name := range names {
+		fmt.Fprintf(w, "  %s\n", consoleActions[name].usage)
+	}
+}
+
+// consoleCreateFloor create floor NAME on top of existing floors, CAPACITY 0 for unlimited.
+func consoleCreateFloor(a *App, dc contexts.BearerContext, out io.Writer, args []string) error {
+	req := request.CreateFloorRequest{Name: args[0], Level: 1}
+	if len(args) > 1 {
+		capacity, err := strconv.Atoi(args[1])
+		if err != nil || capacity < 0 {
+			return fmt.Errorf("floor capacity must be zero or positive number, got %q", args[1])
+		}
+		req.Capacity = capacity
+	}
+	floors, errResp := a.floor.GetFloors(dc, &request.GetFloorRequest{})
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	for _, fl := range floors.Data {
+		if fl.Level >= req.Level {
+			req.Level = fl.Level + 1
+		}
+	}
+	if err := a.validate(req); err != nil {
+		return err
+	}
+	_, errResp = a.floor.CreateFloor(dc, req)
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Created floor %s\n", req.Name)
+	return nil
+}
+
+// consoleCreateParkingLot create COUNT parking lots numbered after lots the site already has, on FLOOR which
+// must exist. Without FLOOR lots go to the lowest floor, `ConsoleFloor` created first when site has no floor yet.
+func consoleCreateParkingLot(a *App, dc contexts.BearerContext, out io.Writer, args []string) error {
+	count, err := strconv.Atoi(args[0])
+	if err != nil || count <= 0 {
+		return fmt.Errorf("parking lot count must be positive number, got %q", args[0])
+	}
+	floor, err := consoleFloor(a, dc, out, args[1:])
+	if err != nil {
+		return err
+	}
+
+	start := 1
+	lots, errResp := a.parkingLot.GetParkingLots(dc, &request.GetParkingLotRequest{})
+	switch {
+	case errResp == nil:
+		start = len(lots.Data) + 1
+	// site without parking lot yet
+	case errResp.Code != strconv.Itoa(errs.NotFound):
+		return failed(errResp)
+	}
+	_, errResp = a.parkingLot.CreateBulkParkingLots(dc, request.CreateBulkParkingLotRequest{
+		Floor: floor,
+		Start: start,
+		End:   start + count - 1,
+	})
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Created %d parking lots: %d-%d on floor %s\n", count, start, start+count-1, floor)
+	return nil
+}
+
+// consoleFloor floor name given in `args`, otherwise lowest floor of site or `ConsoleFloor` created on floorless site.
+func consoleFloor(a *App, dc contexts.BearerContext, out io.Writer, args []string) (string, error) {
+	floors, errResp := a.floor.GetFloors(dc, &request.GetFloorRequest{})
+	if err := failed(errResp); err != nil {
+		return "", err
+	}
+	if len(args) > 0 {
+		for _, fl := range floors.Data {
+			if strings.EqualFold(fl.Name, args[0]) {
+				return fl.Name, nil
+			}
+		}
+		return "", fmt.Errorf("floor %q not found, create it first with create_floor", args[0])
+	}
+	if len(floors.Data) > 0 {
+		return floors.Data[0].Name, nil
+	}
+	if err := consoleCreateFloor(a, dc, out, []string{ConsoleFloor}); err != nil {
+		return "", err
+	}
+	return ConsoleFloor, nil
+}
+
+// consoleCreateTariff create tariff of vehicle TYPE charged on `leave`, vehicle type must have tariff before it leave.
+func consoleCreateTariff(a *App, dc contexts.BearerContext, out io.Writer, args []string) error {
+	firstHourPrice, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("first hour price must be number, got %q", args[1])
+	}
+	percent, err := strconv.Atoi(args[2])
+	if err != nil {
+		return fmt.Errorf("next hour percent must be number, got %q", args[2])
+	}
+	req := request.CreateVehicleRequest{Name: args[0], Type: args[0], FirstHourPrice: firstHourPrice, PricePerHourPercent: percent}
+	if err := a.validate(req); err != nil {
+		return err
+	}
+	_, errResp := a.vehicle.CreateVehicle(dc, req)
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Created tariff %s: first hour %d, next hour %d%%\n", req.Type, firstHourPrice, percent)
+	return nil
+}
+
+// consolePark park vehicle in, allocated parking lot reported, or queue when site full and queue enabled.
+func consolePark(a *App, dc contexts.BearerContext, out io.Writer, args []string) error {
+	req := request.ParkingInRequest{PlatNomor: args[0], Warna: args[1], Tipe: args[2]}
+	if err := a.validate(req); err != nil {
+		return err
+	}
+	result, errResp := a.parking.SetParkingIn(dc, &req)
+	if err := failed(errResp); err != nil {
+		return err
+	}
+
+	sessions, errResp := a.parking.GetParkingSessions(dc, &request.GetParkingSessionRequest{PlatNomor: req.PlatNomor})
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	if len(sessions.Data) == 0 {
+		fmt.Fprintln(out, result.Message)
+		return nil
+	}
+	fmt.Fprintf(out, "Allocated parking lot %s to %s\n", sessions.Data[0].ParkingLot, req.PlatNomor)
+	return nil
+}
+
+// consoleLeave park vehicle out, fee charged on tariff of its type reported, see create_tariff.
+func consoleLeave(a *App, dc contexts.BearerContext, out io.Writer, args []string) error {
+	req := request.ParkingOutRequest{PlatNomor: args[0]}
+	if err := a.validate(req); err != nil {
+		return err
+	}
+	result, errResp := a.parking.SetParkingOut(dc, &req)
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%s left, fee %s\n", result.PlatNomor, result.JumlahBayar)
+	return nil
+}
+
+// consoleStatus vehicles still parked, in order they parked in.
+func consoleStatus(a *App, dc contexts.BearerContext, out io.Writer, _ []string) error {
+	sessions, errResp := a.parking.GetParkingSessions(dc, &request.GetParkingSessionRequest{})
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "LOT\tPLATE\tCOLOR\tTYPE")
+	for _, s := range sessions.Data {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", s.ParkingLot, s.PlatNomor, s.Warna, s.Tipe)
+	}
+	return tw.Flush()
+}
+
+// consolePlatesForColor plates of vehicle of color, comma separated.
+func consolePlatesForColor(a *App, dc contexts.BearerContext, out io.Writer, args []string) error {
+	req := request.GetParkingData{Warna: args[0]}
+	result, errResp := a.parking.GetParkingData(dc, &req)
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	if len(result.PlatNomor) == 0 {
+		fmt.Fprintln(out, "Not found")
+		return nil
+	}
+	fmt.Fprintln(out, strings.Join(result.PlatNomor, ", "))
+	return nil
+}
+
+// consoleCountType number of vehicle of type.
+func consoleCountType(a *App, dc contexts.BearerContext, out io.Writer, args []string) error {
+	req := request.GetCountParkingData{Tipe: args[0]}
+	result, errResp := a.parking.GetCountParkingData(dc, &req)
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	fmt.Fprintln(out, result.JumlahKendaraan)
+	return nil
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
+	validatorRequest "github.com/mhaikalla/parking-service-management-library/pkg/validator"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// update rewrite golden files from actual output, eg: `go test ./components/cli -update`.
+var update = flag.Bool("update", false, "update golden files of console scenarios")
+
+type ConsoleSuite struct {
+	suite.Suite
+}
+
+// newTestApp app on empty storage of temp dir, waiting queue disabled.
+func newTestApp(t *testing.T) *App {
+	conf, err := config.NewConfig(map[string]map[string]interface{}{
+		"file_storage": {"path": t.TempDir() + "/"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	app := NewApp(conf, validatorRequest.NewValidator())
+	app.Actor = ActorPrefix + "test"
+	return app
+}
+
+// TestScenarios run every `testdata/console/*.txt` script on empty storage, output compared with its `.golden` file.
+func (s *ConsoleSuite) TestScenarios() {
+	scripts, err := filepath.Glob(filepath.Join("testdata", "console", "*.txt"))
+	s.Require().NoError(err)
+	s.Require().NotEmpty(scripts)
+
+	for _, script := range scripts {
+		s.Run(filepath.Base(script), func() {
+			app := newTestApp(s.T())
+			out := &bytes.Buffer{}
+			app.Out, app.Err = out, out
+			if err := app.Run([]string{ConsoleCommand, "-file", script}); err != nil {
+				fmt.Fprintf(out, "console: %s\n", err)
+			}
+
+			golden := strings.TrimSuffix(script, ".txt") + ".golden"
+			if *update {
+				s.Require().NoError(os.WriteFile(golden, out.Bytes(), 0644))
+			}
+			expected, err := os.ReadFile(golden)
+			s.Require().NoError(err, "run with -update to create golden file")
+			s.Equal(string(expected), out.String())
+		})
+	}
+}
+
+func TestConsoleSuite(t *testing.T) {
+	suite.Run(t, new(ConsoleSuite))
+}
+
+func TestCommandSuite(t *testing.T) {
+	suite.Run(t, new(CommandSuite))
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+)
+
+var lotActions = map[string]action{
+	"create": {"create parking lot: -name NAME (-floor NAME | -floor-id ID) [-zone-id ID]", createLot},
+	"list":   {"list parking lots", listLots},
+	"delete": {"delete parking lot: -id ID", deleteLot},
+}
+
+func createLot(a *App, c *call) error {
+	req := request.CreateParkingLotRequest{}
+	c.flags.StringVar(&req.Name, "name", "", "parking lot name")
+	c.flags.StringVar(&req.Floor, "floor", "", "floor name")
+	c.flags.IntVar(&req.FloorId, "floor-id", 0, "floor ID")
+	c.flags.IntVar(&req.ZoneId, "zone-id", 0, "zone ID")
+	if err := c.parse(); err != nil {
+		return err
+	}
+	if err := a.validate(req); err != nil {
+		return err
+	}
+
+	result, errResp := a.parkingLot.CreateParkingLot(a.context(c), req)
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	return a.write(c, result, []string{"RESULT", "NAME", "FLOOR"}, [][]string{{result.Message, req.Name, req.Floor}})
+}
+
+func listLots(a *App, c *call) error {
+	req := request.GetParkingLotRequest{}
+	if err := c.parse(); err != nil {
+		return err
+	}
+
+	result, errResp := a.parkingLot.GetParkingLots(a.context(c), &req)
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	rows := [][]string{}
+	for _, lot := range result.Data {
+		rows = append(rows, []string{
+			strconv.Itoa(lot.Id), lot.Name, lot.Floor, strconv.Itoa(lot.ZoneId), lot.Status, strconv.FormatBool(lot.IsParked),
+		})
+	}
+	return a.write(c, result, []string{"ID", "NAME", "FLOOR", "ZONE", "STATUS", "PARKED"}, rows)
+}
+
+func deleteLot(a *App, c *call) error {
+	req := request.DeleteParkingLotRequest{}
+	c.flags.StringVar(&req.ParkingLotId, "id", "", "parking lot ID")
+	if err := c.parse(); err != nil {
+		return err
+	}
+	if err := a.validate(req); err != nil {
+		return err
+	}
+
+	result, errResp := a.parkingLot.DeleteParkingLots(a.context(c), &req)
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	return a.write(c, result, []string{"RESULT", "ID"}, [][]string{{result.Message, req.ParkingLotId}})
+}
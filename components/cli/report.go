@@ -0,0 +1,27 @@
+package cli
+
+import "strconv"
+
+var reportActions = map[string]action{
+	"revenue": {"parked out count and revenue of every site", reportRevenue},
+}
+
+func reportRevenue(a *App, c *call) error {
+	if err := c.parse(); err != nil {
+		return err
+	}
+
+	result, errResp := a.site.GetSiteReports(a.context(c))
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	rows := [][]string{}
+	for _, r := range result.Data {
+		rows = append(rows, []string{strconv.Itoa(r.SiteId), r.Code, r.Name,
+			strconv.Itoa(r.TotalParkingIn), strconv.Itoa(r.TotalParkingOut), strconv.Itoa(r.Revenue)})
+	}
+	total := result.Total
+	rows = append(rows, []string{"-", total.Code, total.Name,
+		strconv.Itoa(total.TotalParkingIn), strconv.Itoa(total.TotalParkingOut), strconv.Itoa(total.Revenue)})
+	return a.write(c, result, []string{"SITE", "CODE", "NAME", "PARKED", "PARKED_OUT", "REVENUE"}, rows)
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+)
+
+var sessionActions = map[string]action{
+	"list":  {"list sessions of vehicle still parked, every session with -all: [-plate PLATE] [-all]", listSessions},
+	"close": {"park out vehicle, eg: stuck session: -plate PLATE", closeSession},
+}
+
+func listSessions(a *App, c *call) error {
+	req := request.GetParkingSessionRequest{}
+	c.flags.StringVar(&req.PlatNomor, "plate", "", "plate number")
+	c.flags.BoolVar(&req.All, "all", false, "include sessions already parked out")
+	if err := c.parse(); err != nil {
+		return err
+	}
+
+	result, errResp := a.parking.GetParkingSessions(a.context(c), &req)
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	rows := [][]string{}
+	for _, s := range result.Data {
+		out := "-"
+		if s.TanggalKeluar != nil {
+			out = s.TanggalKeluar.Format(time.RFC3339)
+		}
+		rows = append(rows, []string{
+			strconv.Itoa(s.Id), s.PlatNomor, s.Warna, s.Tipe, s.ParkingLot, s.Status,
+			s.TanggalMasuk.Format(time.RFC3339), out, strconv.Itoa(s.JumlahBayar),
+		})
+	}
+	return a.write(c, result, []string{"ID", "PLATE", "COLOR", "TYPE", "LOT", "STATUS", "IN", "OUT", "FEE"}, rows)
+}
+
+func closeSession(a *App, c *call) error {
+	req := request.ParkingOutRequest{}
+	c.flags.StringVar(&req.PlatNomor, "plate", "", "plate number")
+	if err := c.parse(); err != nil {
+		return err
+	}
+	if err := a.validate(req); err != nil {
+		return err
+	}
+
+	result, errResp := a.parking.SetParkingOut(a.context(c), &req)
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	return a.write(c, result, []string{"PLATE", "IN", "OUT", "FEE"}, [][]string{{
+		result.PlatNomor, result.TanggalMasuk.Format(time.RFC3339), result.TanggalKeluar.Format(time.RFC3339), result.JumlahBayar,
+	}})
+}
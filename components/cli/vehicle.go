@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+)
+
+var vehicleActions = map[string]action{
+	"create": {"create vehicle type: -name NAME -type TYPE -first-hour-price N -price-per-hour-percent N", createVehicle},
+	"list":   {"list vehicle types", listVehicles},
+	"update": {"update vehicle type: -id ID -name NAME -type TYPE -first-hour-price N -price-per-hour-percent N", updateVehicle},
+	"delete": {"delete vehicle type: -id ID", deleteVehicle},
+}
+
+func createVehicle(a *App, c *call) error {
+	req := request.CreateVehicleRequest{}
+	c.flags.StringVar(&req.Name, "name", "", "vehicle name")
+	c.flags.StringVar(&req.Type, "type", "", "vehicle type given on parking in, eg: SUV")
+	c.flags.IntVar(&req.FirstHourPrice, "first-hour-price", 0, "price of first hour")
+	c.flags.IntVar(&req.PricePerHourPercent, "price-per-hour-percent", 0, "percent of first hour price charged every next hour")
+	if err := c.parse(); err != nil {
+		return err
+	}
+	if err := a.validate(req); err != nil {
+		return err
+	}
+
+	result, errResp := a.vehicle.CreateVehicle(a.context(c), req)
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	return a.write(c, result, []string{"RESULT", "NAME", "TYPE"}, [][]string{{result.Message, req.Name, req.Type}})
+}
+
+func listVehicles(a *App, c *call) error {
+	req := request.GetVehicleRequest{}
+	if err := c.parse(); err != nil {
+		return err
+	}
+
+	result, errResp := a.vehicle.GetVehicles(a.context(c), &req)
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	rows := [][]string{}
+	for _, v := range result.Data {
+		rows = append(rows, []string{
+			strconv.Itoa(v.Id), v.Name, v.Type, strconv.Itoa(v.FirstHourPrice), strconv.Itoa(v.PricePerHourPercent),
+		})
+	}
+	return a.write(c, result, []string{"ID", "NAME", "TYPE", "FIRST_HOUR_PRICE", "PRICE_PER_HOUR_PERCENT"}, rows)
+}
+
+func updateVehicle(a *App, c *call) error {
+	req := request.UpdateVehicleRequest{}
+	c.flags.IntVar(&req.Id, "id", 0, "vehicle ID")
+	c.flags.StringVar(&req.Name, "name", "", "vehicle name")
+	c.flags.StringVar(&req.Type, "type", "", "vehicle type given on parking in, eg: SUV")
+	c.flags.IntVar(&req.FirstHourPrice, "first-hour-price", 0, "price of first hour")
+	c.flags.IntVar(&req.PricePerHourPercent, "price-per-hour-percent", 0, "percent of first hour price charged every next hour")
+	if err := c.parse(); err != nil {
+		return err
+	}
+	if err := a.validate(req); err != nil {
+		return err
+	}
+
+	result, errResp := a.vehicle.UpdateVehicle(a.context(c), req)
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	return a.write(c, result, []string{"RESULT", "ID"}, [][]string{{result.Message, strconv.Itoa(req.Id)}})
+}
+
+func deleteVehicle(a *App, c *call) error {
+	req := request.DeleteVehicleRequest{}
+	c.flags.StringVar(&req.VehicleId, "id", "", "vehicle ID")
+	if err := c.parse(); err != nil {
+		return err
+	}
+	if err := a.validate(req); err != nil {
+		return err
+	}
+
+	result, errResp := a.vehicle.DeleteVehicles(a.context(c), &req)
+	if err := failed(errResp); err != nil {
+		return err
+	}
+	return a.write(c, result, []string{"RESULT", "ID"}, [][]string{{result.Message, req.VehicleId}})
+}
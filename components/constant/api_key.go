@@ -0,0 +1,11 @@
+package constant
+
+// Scope of API key issued for gate hardware.
+const (
+	ScopeParkingIn  = "parking-in"
+	ScopeParkingOut = "parking-out"
+	ScopeReadOnly   = "read-only"
+)
+
+// ApiKeyPrefix prefix of every issued API key, make leaked key easy to recognize.
+const ApiKeyPrefix = "psm_"
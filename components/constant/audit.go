@@ -0,0 +1,12 @@
+package constant
+
+// audit trail action of mutation
+const (
+	AuditCreate     = "create"
+	AuditUpdate     = "update"
+	AuditDelete     = "delete"
+	AuditParkingIn  = "parking_in"
+	AuditParkingOut = "parking_out"
+	AuditRotate     = "rotate"
+	AuditRevoke     = "revoke"
+)
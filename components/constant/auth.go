@@ -0,0 +1,13 @@
+package constant
+
+// Grant type accepted by token endpoint.
+const (
+	GrantPassword          = "password"
+	GrantClientCredentials = "client_credentials"
+)
+
+// Subject type of issued token.
+const (
+	SubjectOperator   = "operator"
+	SubjectGateDevice = "gate-device"
+)
@@ -0,0 +1,12 @@
+package constant
+
+const (
+	BulkCreated = "CREATED"
+	BulkUpdated = "UPDATED"
+	BulkDeleted = "DELETED"
+	BulkFailed  = "FAILED"
+	BulkSkipped = "SKIPPED"
+
+	// BulkMaxItems limit parking lot processed by one bulk request.
+	BulkMaxItems = 1000
+)
@@ -0,0 +1,7 @@
+package constant
+
+// Status of health and readiness check.
+const (
+	HealthUp   = "UP"
+	HealthDown = "DOWN"
+)
@@ -0,0 +1,4 @@
+package constant
+
+// Currency of every price and amount charged, API v2 send it along with each amount.
+const Currency = "IDR"
@@ -0,0 +1,17 @@
+package constant
+
+const (
+	LotAvailable   = "AVAILABLE"
+	LotOccupied    = "OCCUPIED"
+	LotMaintenance = "MAINTENANCE"
+	LotReserved    = "RESERVED"
+	LotDisabled    = "DISABLED"
+)
+
+// LotManualStatus is parking lot status that can be set by admin, the others are derived.
+var LotManualStatus = map[string]bool{
+	LotAvailable:   true,
+	LotMaintenance: true,
+	LotReserved:    true,
+	LotDisabled:    true,
+}
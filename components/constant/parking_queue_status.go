@@ -0,0 +1,17 @@
+package constant
+
+const (
+	QueueWaiting   = 1
+	QueueOffered   = 2
+	QueueParked    = 3
+	QueueCancelled = 4
+	QueueExpired   = 5
+)
+
+var QueueStatusName = map[int]string{
+	QueueWaiting:   "WAITING",
+	QueueOffered:   "OFFERED",
+	QueueParked:    "PARKED",
+	QueueCancelled: "CANCELLED",
+	QueueExpired:   "EXPIRED",
+}
@@ -0,0 +1,9 @@
+package constant
+
+// Role claimed on bearer token `roles`.
+const (
+	RoleAdmin      = "admin"
+	RoleOperator   = "operator"
+	RoleGateDevice = "gate-device"
+	RoleAuditor    = "auditor"
+)
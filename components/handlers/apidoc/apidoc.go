@@ -0,0 +1,66 @@
+package apidoc
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/openapi"
+	"github.com/mhaikalla/parking-service-management-library/pkg/router"
+)
+
+const (
+	// SpecPath route serving OpenAPI document.
+	SpecPath = "/openapi.json"
+	// UIPath route serving Swagger UI page.
+	UIPath = "/docs"
+)
+
+type Handlers struct {
+	Config    *config.Config
+	generator openapi.Generator
+	routes    func() []router.RouteInfo
+}
+
+// NewApiDocHandlers handlers documenting routes returned by `routes`, called on every request so route registered
+// after handlers created still documented.
+func NewApiDocHandlers(
+	config *config.Config,
+	routes func() []router.RouteInfo,
+) (handler *Handlers, err error) {
+	defer func() {
+		if r, ok := recover().(error); r != nil && ok {
+			err = r
+		}
+	}()
+	return &Handlers{
+		Config:    config,
+		generator: NewGenerator(),
+		routes:    routes,
+	}, nil
+}
+
+// NewGenerator generator of service document, error response documented as errs.Errs.
+func NewGenerator() openapi.Generator {
+	return openapi.Generator{
+		Info: openapi.Info{
+			Title:       "Parking Service Management",
+			Description: "Parking lot, floor, zone, vehicle type and parking management of sites.",
+			Version:     "v1",
+		},
+		Error: errs.Errs{},
+	}
+}
+
+// Generate document of `routes`, returning routes without documented operation.
+func Generate(generator openapi.Generator, routes []router.RouteInfo) (*openapi.Document, []openapi.Route) {
+	docRoutes := make([]openapi.Route, 0, len(routes))
+	for _, r := range routes {
+		docRoutes = append(docRoutes, openapi.Route{
+			Method: r.Method,
+			Path:   r.Path,
+			Auth:   r.Auth,
+			Roles:  r.Permission.Roles,
+			Scopes: r.Permission.Scopes,
+		})
+	}
+	return generator.Generate(docRoutes, findOperation)
+}
@@ -0,0 +1,18 @@
+package apidoc
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+func (h *Handlers) GetOpenAPI() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		doc, missing := Generate(h.generator, h.routes())
+		if len(missing) > 0 {
+			bc.GetLogger().Warn("routes without OpenAPI operation: ", missing)
+		}
+
+		return bc.JSON(200, doc)
+	}
+}
@@ -0,0 +1,14 @@
+package apidoc
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/openapi"
+)
+
+func (h *Handlers) GetSwaggerUI() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		return bc.HTMLBlob(200, openapi.SwaggerUI(h.generator.Info.Title, SpecPath))
+	}
+}
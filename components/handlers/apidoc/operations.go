@@ -0,0 +1,177 @@
+package apidoc
+
+import (
+	"strings"
+
+	"github.com/mhaikalla/parking-service-management-library/components/models/apiv2"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/openapi"
+)
+
+const (
+	basePath   = "/api/v1/parking-management"
+	basePathV2 = "/api/v2/parking-management"
+	sitePath   = "/sites/:siteId"
+)
+
+// message responses whose `data` documented, same shape as response.BaseMessageResponse
+
+type OperatorMessageResponse struct {
+	Message string                             `json:"message"`
+	Data    response.GetDetailOperatorResponse `json:"data"`
+}
+
+type GateDeviceMessageResponse struct {
+	Message string                            `json:"message"`
+	Data    response.CreateGateDeviceResponse `json:"data"`
+}
+
+type ApiKeyMessageResponse struct {
+	Message string                        `json:"message"`
+	Data    response.CreateApiKeyResponse `json:"data"`
+}
+
+type BulkParkingLotMessageResponse struct {
+	Message string                          `json:"message"`
+	Data    response.BulkParkingLotResponse `json:"data"`
+}
+
+type MaintenanceWindowMessageResponse struct {
+	Message string                                      `json:"message"`
+	Data    response.GetDetailMaintenanceWindowResponse `json:"data"`
+}
+
+var (
+	siteIdParam = openapi.Parameter{
+		Name:        "siteId",
+		In:          "path",
+		Description: "Site of the request, default site of caller when route called without it",
+		Schema:      &openapi.Schema{Type: "integer", Format: "int32"},
+	}
+	idParam = openapi.Parameter{Name: "id", In: "path", Schema: &openapi.Schema{Type: "integer", Format: "int32"}}
+	// ifMatchParam version given on update instead of body `version`
+	ifMatchParam = openapi.Parameter{
+		Name:        "If-Match",
+		In:          "header",
+		Description: "ETag of detail response, `*` skip version check",
+		Schema:      &openapi.Schema{Type: "string"},
+	}
+)
+
+// operations documented models of every route keyed by method and path, path of `/api/v1/parking-management`
+// routes written without that prefix and shared with its `/sites/:siteId` variant.
+var operations = map[string]openapi.Operation{
+	"GET /openapi.json": {Summary: "OpenAPI document of this service", Tags: []string{"docs"}},
+	"GET /docs":         {Summary: "Swagger UI page of OpenAPI document", Tags: []string{"docs"}},
+	"GET /healthz":      {Summary: "Liveness probe", Tags: []string{"health"}, Response: response.HealthResponse{}},
+	"GET /readyz":       {Summary: "Readiness probe, 503 when storage, tables or database check down", Tags: []string{"health"}, Response: response.ReadinessResponse{}},
+	"GET /version":      {Summary: "Build metadata", Tags: []string{"health"}, Response: response.VersionResponse{}},
+	"GET /metrics":      {Summary: "Metrics on Prometheus text format", Tags: []string{"health"}},
+
+	"POST /auth/token":   {Summary: "Issue access and refresh token", Tags: []string{"auth"}, Request: request.TokenRequest{}, Response: response.TokenResponse{}},
+	"POST /auth/refresh": {Summary: "Rotate refresh token", Tags: []string{"auth"}, Request: request.RefreshTokenRequest{}, Response: response.TokenResponse{}},
+	"POST /auth/revoke":  {Summary: "Revoke token", Tags: []string{"auth"}, Request: request.RevokeTokenRequest{}, Response: response.BaseMessageResponse{}},
+
+	"GET /auth/operators":       {Summary: "List operators", Tags: []string{"auth"}, Query: request.BaseGetListParams{}, Response: response.GetOperatorsResponse{}},
+	"POST /auth/operator":       {Summary: "Create operator", Tags: []string{"auth"}, Request: request.CreateOperatorRequest{}, Response: OperatorMessageResponse{}, Status: 201},
+	"PUT /auth/operator":        {Summary: "Update operator", Tags: []string{"auth"}, Params: []openapi.Parameter{ifMatchParam}, Request: request.UpdateOperatorRequest{}, Response: OperatorMessageResponse{}},
+	"DELETE /auth/operator":     {Summary: "Delete operator", Tags: []string{"auth"}, Request: request.DeleteOperatorRequest{}, Response: response.BaseMessageResponse{}},
+	"GET /auth/gate-devices":    {Summary: "List gate devices", Tags: []string{"auth"}, Query: request.BaseGetListParams{}, Response: response.GetGateDevicesResponse{}},
+	"POST /auth/gate-device":    {Summary: "Register gate device", Tags: []string{"auth"}, Request: request.CreateGateDeviceRequest{}, Response: GateDeviceMessageResponse{}, Status: 201},
+	"DELETE /auth/gate-device":  {Summary: "Delete gate device", Tags: []string{"auth"}, Request: request.DeleteGateDeviceRequest{}, Response: response.BaseMessageResponse{}},
+	"GET /auth/api-keys":        {Summary: "List API keys", Tags: []string{"auth"}, Query: request.BaseGetListParams{}, Response: response.GetApiKeysResponse{}},
+	"POST /auth/api-key":        {Summary: "Create API key", Tags: []string{"auth"}, Request: request.CreateApiKeyRequest{}, Response: ApiKeyMessageResponse{}, Status: 201},
+	"POST /auth/api-key/rotate": {Summary: "Rotate API key", Tags: []string{"auth"}, Request: request.RotateApiKeyRequest{}, Response: ApiKeyMessageResponse{}, Status: 201},
+	"DELETE /auth/api-key":      {Summary: "Revoke API key", Tags: []string{"auth"}, Request: request.RevokeApiKeyRequest{}, Response: response.BaseMessageResponse{}},
+
+	"POST /parking-in":            {Summary: "Park vehicle, queued when no parking lot available", Tags: []string{"parking"}, Request: request.ParkingInRequest{}, Response: response.BaseMessageResponse{}},
+	"POST /parking-out":           {Summary: "Leave parking and charge fee", Tags: []string{"parking"}, Request: request.ParkingOutRequest{}, Response: response.ParkingOutResponse{}},
+	"GET /get-parking-data":       {Summary: "Parked vehicles by color and type", Tags: []string{"parking"}, Request: request.GetParkingData{}, Response: response.GetDataParkingResponse{}},
+	"GET /get-count-parking-data": {Summary: "Count parked vehicles by type", Tags: []string{"parking"}, Request: request.GetCountParkingData{}, Response: response.GetCountParkingResponse{}},
+	"GET /parking-queue":          {Summary: "List parking queue", Tags: []string{"parking"}, Response: response.GetParkingQueueResponse{}},
+	"DELETE /parking-queue":       {Summary: "Leave parking queue", Tags: []string{"parking"}, Request: request.CancelParkingQueueRequest{}, Response: response.BaseMessageResponse{}},
+
+	"GET /parking-lot/:id":      {Summary: "Detail parking lot", Tags: []string{"parking-lot"}, Params: []openapi.Parameter{idParam}, Response: response.GetDetailParkingLotResponse{}},
+	"GET /parking-lots":         {Summary: "List parking lots", Tags: []string{"parking-lot"}, Query: request.BaseGetListParams{}, Response: response.GetParkingLotsResponse{}},
+	"POST /parking-lot":         {Summary: "Create parking lot", Tags: []string{"parking-lot"}, Request: request.CreateParkingLotRequest{}, Response: response.BaseMessageResponse{}, Status: 201},
+	"PUT /parking-lot":          {Summary: "Update parking lot", Tags: []string{"parking-lot"}, Params: []openapi.Parameter{ifMatchParam}, Request: request.UpdateParkingLotRequest{}, Response: response.BaseMessageResponse{}},
+	"DELETE /parking-lot":       {Summary: "Delete parking lot", Tags: []string{"parking-lot"}, Request: request.DeleteParkingLotRequest{}, Response: response.BaseMessageResponse{}},
+	"PUT /parking-lot/status":   {Summary: "Set parking lot status", Tags: []string{"parking-lot"}, Params: []openapi.Parameter{ifMatchParam}, Request: request.SetParkingLotStatusRequest{}, Response: response.BaseMessageResponse{}},
+	"POST /parking-lots/bulk":   {Summary: "Create parking lots in bulk", Tags: []string{"parking-lot"}, Request: request.CreateBulkParkingLotRequest{}, Response: BulkParkingLotMessageResponse{}, Status: 201},
+	"PUT /parking-lots/bulk":    {Summary: "Update parking lots in bulk", Tags: []string{"parking-lot"}, Request: request.UpdateBulkParkingLotRequest{}, Response: BulkParkingLotMessageResponse{}},
+	"DELETE /parking-lots/bulk": {Summary: "Delete parking lots in bulk", Tags: []string{"parking-lot"}, Request: request.DeleteBulkParkingLotRequest{}, Response: BulkParkingLotMessageResponse{}},
+
+	"GET /maintenance-window/:id": {Summary: "Detail maintenance window", Tags: []string{"maintenance-window"}, Params: []openapi.Parameter{idParam}, Response: response.GetDetailMaintenanceWindowResponse{}},
+	"GET /maintenance-windows": {
+		Summary:  "List maintenance windows",
+		Tags:     []string{"maintenance-window"},
+		Query:    request.BaseGetListParams{},
+		Params:   []openapi.Parameter{{Name: "active", In: "query", Description: "Only window not ended yet", Schema: &openapi.Schema{Type: "boolean"}}},
+		Response: response.GetMaintenanceWindowsResponse{},
+	},
+	"POST /maintenance-window":   {Summary: "Schedule maintenance window", Tags: []string{"maintenance-window"}, Request: request.CreateMaintenanceWindowRequest{}, Response: MaintenanceWindowMessageResponse{}, Status: 201},
+	"DELETE /maintenance-window": {Summary: "Delete maintenance window", Tags: []string{"maintenance-window"}, Request: request.DeleteMaintenanceWindowRequest{}, Response: response.BaseMessageResponse{}},
+
+	"GET /floor/:id":      {Summary: "Detail floor", Tags: []string{"floor"}, Params: []openapi.Parameter{idParam}, Response: response.GetDetailFloorResponse{}},
+	"GET /floors":         {Summary: "List floors", Tags: []string{"floor"}, Query: request.BaseGetListParams{}, Response: response.GetFloorsResponse{}},
+	"POST /floor":         {Summary: "Create floor", Tags: []string{"floor"}, Request: request.CreateFloorRequest{}, Response: response.BaseMessageResponse{}, Status: 201},
+	"PUT /floor":          {Summary: "Update floor", Tags: []string{"floor"}, Params: []openapi.Parameter{ifMatchParam}, Request: request.UpdateFloorRequest{}, Response: response.BaseMessageResponse{}},
+	"PUT /floor/status":   {Summary: "Set floor status", Tags: []string{"floor"}, Params: []openapi.Parameter{ifMatchParam}, Request: request.SetFloorStatusRequest{}, Response: response.BaseMessageResponse{}},
+	"DELETE /floor":       {Summary: "Delete floor with its zones", Tags: []string{"floor"}, Request: request.DeleteFloorRequest{}, Response: response.BaseMessageResponse{}},
+	"GET /reports/floors": {Summary: "Occupancy per floor", Tags: []string{"floor"}, Response: response.GetFloorOccupancyResponse{}},
+
+	"GET /zone/:id": {Summary: "Detail zone", Tags: []string{"zone"}, Params: []openapi.Parameter{idParam}, Response: response.GetDetailZoneResponse{}},
+	"GET /zones":    {Summary: "List zones", Tags: []string{"zone"}, Query: request.GetZoneRequest{}, Response: response.GetZonesResponse{}},
+	"POST /zone":    {Summary: "Create zone", Tags: []string{"zone"}, Request: request.CreateZoneRequest{}, Response: response.BaseMessageResponse{}, Status: 201},
+	"PUT /zone":     {Summary: "Update zone", Tags: []string{"zone"}, Params: []openapi.Parameter{ifMatchParam}, Request: request.UpdateZoneRequest{}, Response: response.BaseMessageResponse{}},
+	"DELETE /zone":  {Summary: "Delete zone", Tags: []string{"zone"}, Request: request.DeleteZoneRequest{}, Response: response.BaseMessageResponse{}},
+
+	"GET /vehicle/:id": {Summary: "Detail vehicle type", Tags: []string{"vehicle"}, Params: []openapi.Parameter{idParam}, Response: response.GetDetailVehicleResponse{}},
+	"GET /vehicles":    {Summary: "List vehicle types", Tags: []string{"vehicle"}, Query: request.BaseGetListParams{}, Response: response.GetVehiclesResponse{}},
+	"POST /vehicle":    {Summary: "Create vehicle type", Tags: []string{"vehicle"}, Request: request.CreateVehicleRequest{}, Response: response.BaseMessageResponse{}, Status: 201},
+	"PUT /vehicle":     {Summary: "Update vehicle type", Tags: []string{"vehicle"}, Params: []openapi.Parameter{ifMatchParam}, Request: request.UpdateVehicleRequest{}, Response: response.BaseMessageResponse{}},
+	"DELETE /vehicles": {Summary: "Delete vehicle type", Tags: []string{"vehicle"}, Request: request.DeleteVehicleRequest{}, Response: response.BaseMessageResponse{}},
+
+	"GET /site/:id":      {Summary: "Detail site", Tags: []string{"site"}, Params: []openapi.Parameter{idParam}, Response: response.GetDetailSiteResponse{}},
+	"GET /sites":         {Summary: "List sites", Tags: []string{"site"}, Query: request.BaseGetListParams{}, Response: response.GetSitesResponse{}},
+	"POST /site":         {Summary: "Create site", Tags: []string{"site"}, Request: request.CreateSiteRequest{}, Response: response.BaseMessageResponse{}, Status: 201},
+	"PUT /site":          {Summary: "Update site", Tags: []string{"site"}, Params: []openapi.Parameter{ifMatchParam}, Request: request.UpdateSiteRequest{}, Response: response.BaseMessageResponse{}},
+	"DELETE /site":       {Summary: "Delete site", Tags: []string{"site"}, Request: request.DeleteSiteRequest{}, Response: response.BaseMessageResponse{}},
+	"GET /reports/sites": {Summary: "Occupancy and revenue per site", Tags: []string{"site"}, Response: response.GetSiteReportsResponse{}},
+
+	"GET /audit": {
+		Summary:  "Query audit trail, newest first",
+		Tags:     []string{"audit"},
+		Query:    request.GetAuditLogRequest{},
+		Response: response.GetAuditLogsResponse{},
+	},
+	"GET /audit/verify": {Summary: "Verify hash chain of audit trail", Tags: []string{"audit"}, Response: response.VerifyAuditLogResponse{}},
+}
+
+// findOperation operation of route `r`, route under `/sites/:siteId` share operation of default site route.
+func findOperation(r openapi.Route) (openapi.Operation, bool) {
+	base, ops := basePath, operations
+	if strings.HasPrefix(r.Path, basePathV2+"/") {
+		base, ops = basePathV2, operationsV2
+	}
+
+	path := r.Path
+	siteScoped := false
+	switch {
+	case strings.HasPrefix(path, base+sitePath+"/"):
+		path = strings.TrimPrefix(path, base+sitePath)
+		siteScoped = true
+	case strings.HasPrefix(path, base+"/"):
+		path = strings.TrimPrefix(path, base)
+	}
+
+	op, ok := ops[strings.ToUpper(r.Method)+" "+path]
+	if ok && base == basePathV2 {
+		op.Envelope = apiv2.Envelope{}
+	}
+	if ok && siteScoped {
+		op.Params = append([]openapi.Parameter{siteIdParam}, op.Params...)
+	}
+	return op, ok
+}
@@ -0,0 +1,82 @@
+package apidoc
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/components/models/apiv2"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/openapi"
+)
+
+// tags of API v2 operations, kept apart from v1 on Swagger UI
+var (
+	tagParkingV2           = []string{"v2 parking"}
+	tagParkingLotV2        = []string{"v2 parking-lot"}
+	tagMaintenanceWindowV2 = []string{"v2 maintenance-window"}
+	tagFloorV2             = []string{"v2 floor"}
+	tagZoneV2              = []string{"v2 zone"}
+	tagVehicleV2           = []string{"v2 vehicle"}
+	tagSiteV2              = []string{"v2 site"}
+	tagAuditV2             = []string{"v2 audit"}
+)
+
+// operationsV2 documented models of `/api/v2/parking-management` routes, keyed same as `operations`.
+// Every response wrapped on apiv2.Envelope.
+var operationsV2 = map[string]openapi.Operation{
+	"POST /parking-in":            {Summary: "Park vehicle, 202 with queue entry when no parking lot available", Tags: tagParkingV2, Request: apiv2.ParkingInRequest{}, Response: apiv2.ParkingEntry{}, Status: 201},
+	"POST /parking-out":           {Summary: "Leave parking and charge fee", Tags: tagParkingV2, Request: apiv2.ParkingOutRequest{}, Response: apiv2.ParkingReceipt{}},
+	"GET /get-parking-data":       {Summary: "Parked vehicles by color and type", Tags: tagParkingV2, Query: apiv2.GetParkedVehiclesRequest{}, Response: apiv2.ParkedVehicles{}},
+	"GET /get-count-parking-data": {Summary: "Count parked vehicles by type", Tags: tagParkingV2, Query: apiv2.CountParkedVehiclesRequest{}, Response: apiv2.ParkedVehicleCount{}},
+	"GET /parking-queue":          {Summary: "List parking queue", Tags: tagParkingV2, Response: []apiv2.QueueEntry{}},
+	"DELETE /parking-queue":       {Summary: "Leave parking queue", Tags: tagParkingV2, Request: apiv2.CancelParkingQueueRequest{}},
+
+	"GET /parking-lot/:id":      {Summary: "Detail parking lot", Tags: tagParkingLotV2, Params: []openapi.Parameter{idParam}, Response: apiv2.ParkingLot{}},
+	"GET /parking-lots":         {Summary: "List parking lots", Tags: tagParkingLotV2, Query: request.BaseGetListParams{}, Response: []apiv2.ParkingLot{}},
+	"POST /parking-lot":         {Summary: "Create parking lot", Tags: tagParkingLotV2, Request: request.CreateParkingLotRequest{}, Response: request.CreateParkingLotRequest{}, Status: 201},
+	"PUT /parking-lot":          {Summary: "Update parking lot", Tags: tagParkingLotV2, Params: []openapi.Parameter{ifMatchParam}, Request: request.UpdateParkingLotRequest{}, Response: request.UpdateParkingLotRequest{}},
+	"DELETE /parking-lot":       {Summary: "Delete parking lot", Tags: tagParkingLotV2, Request: request.DeleteParkingLotRequest{}},
+	"PUT /parking-lot/status":   {Summary: "Set parking lot status", Tags: tagParkingLotV2, Params: []openapi.Parameter{ifMatchParam}, Request: request.SetParkingLotStatusRequest{}, Response: request.SetParkingLotStatusRequest{}},
+	"POST /parking-lots/bulk":   {Summary: "Create parking lots in bulk", Tags: tagParkingLotV2, Request: request.CreateBulkParkingLotRequest{}, Response: response.BulkParkingLotResponse{}, Status: 201},
+	"PUT /parking-lots/bulk":    {Summary: "Update parking lots in bulk", Tags: tagParkingLotV2, Request: request.UpdateBulkParkingLotRequest{}, Response: response.BulkParkingLotResponse{}},
+	"DELETE /parking-lots/bulk": {Summary: "Delete parking lots in bulk", Tags: tagParkingLotV2, Request: request.DeleteBulkParkingLotRequest{}, Response: response.BulkParkingLotResponse{}},
+
+	"GET /maintenance-window/:id": {Summary: "Detail maintenance window", Tags: tagMaintenanceWindowV2, Params: []openapi.Parameter{idParam}, Response: response.GetDetailMaintenanceWindowResponse{}},
+	"GET /maintenance-windows": {
+		Summary:  "List maintenance windows",
+		Tags:     tagMaintenanceWindowV2,
+		Query:    request.BaseGetListParams{},
+		Params:   []openapi.Parameter{{Name: "active", In: "query", Description: "Only window not ended yet", Schema: &openapi.Schema{Type: "boolean"}}},
+		Response: []response.GetDetailMaintenanceWindowResponse{},
+	},
+	"POST /maintenance-window":   {Summary: "Schedule maintenance window", Tags: tagMaintenanceWindowV2, Request: request.CreateMaintenanceWindowRequest{}, Response: response.GetDetailMaintenanceWindowResponse{}, Status: 201},
+	"DELETE /maintenance-window": {Summary: "Delete maintenance window", Tags: tagMaintenanceWindowV2, Request: request.DeleteMaintenanceWindowRequest{}},
+
+	"GET /floor/:id":      {Summary: "Detail floor", Tags: tagFloorV2, Params: []openapi.Parameter{idParam}, Response: response.GetDetailFloorResponse{}},
+	"GET /floors":         {Summary: "List floors", Tags: tagFloorV2, Query: request.BaseGetListParams{}, Response: []response.GetDetailFloorResponse{}},
+	"POST /floor":         {Summary: "Create floor", Tags: tagFloorV2, Request: request.CreateFloorRequest{}, Response: request.CreateFloorRequest{}, Status: 201},
+	"PUT /floor":          {Summary: "Update floor", Tags: tagFloorV2, Params: []openapi.Parameter{ifMatchParam}, Request: request.UpdateFloorRequest{}, Response: request.UpdateFloorRequest{}},
+	"PUT /floor/status":   {Summary: "Set floor status", Tags: tagFloorV2, Params: []openapi.Parameter{ifMatchParam}, Request: request.SetFloorStatusRequest{}, Response: request.SetFloorStatusRequest{}},
+	"DELETE /floor":       {Summary: "Delete floor with its zones", Tags: tagFloorV2, Request: request.DeleteFloorRequest{}},
+	"GET /reports/floors": {Summary: "Occupancy per floor", Tags: tagFloorV2, Response: []response.FloorOccupancyResponse{}},
+
+	"GET /zone/:id": {Summary: "Detail zone", Tags: tagZoneV2, Params: []openapi.Parameter{idParam}, Response: response.GetDetailZoneResponse{}},
+	"GET /zones":    {Summary: "List zones", Tags: tagZoneV2, Query: request.GetZoneRequest{}, Response: []response.GetDetailZoneResponse{}},
+	"POST /zone":    {Summary: "Create zone", Tags: tagZoneV2, Request: request.CreateZoneRequest{}, Response: request.CreateZoneRequest{}, Status: 201},
+	"PUT /zone":     {Summary: "Update zone", Tags: tagZoneV2, Params: []openapi.Parameter{ifMatchParam}, Request: request.UpdateZoneRequest{}, Response: request.UpdateZoneRequest{}},
+	"DELETE /zone":  {Summary: "Delete zone", Tags: tagZoneV2, Request: request.DeleteZoneRequest{}},
+
+	"GET /vehicle/:id": {Summary: "Detail vehicle type", Tags: tagVehicleV2, Params: []openapi.Parameter{idParam}, Response: apiv2.Vehicle{}},
+	"GET /vehicles":    {Summary: "List vehicle types", Tags: tagVehicleV2, Query: request.BaseGetListParams{}, Response: []apiv2.Vehicle{}},
+	"POST /vehicle":    {Summary: "Create vehicle type", Tags: tagVehicleV2, Request: request.CreateVehicleRequest{}, Response: apiv2.Vehicle{}, Status: 201},
+	"PUT /vehicle":     {Summary: "Update vehicle type", Tags: tagVehicleV2, Params: []openapi.Parameter{ifMatchParam}, Request: request.UpdateVehicleRequest{}, Response: apiv2.Vehicle{}},
+	"DELETE /vehicles": {Summary: "Delete vehicle type", Tags: tagVehicleV2, Request: request.DeleteVehicleRequest{}},
+
+	"GET /site/:id":      {Summary: "Detail site", Tags: tagSiteV2, Params: []openapi.Parameter{idParam}, Response: response.GetDetailSiteResponse{}},
+	"GET /sites":         {Summary: "List sites", Tags: tagSiteV2, Query: request.BaseGetListParams{}, Response: []response.GetDetailSiteResponse{}},
+	"POST /site":         {Summary: "Create site", Tags: tagSiteV2, Request: request.CreateSiteRequest{}, Response: request.CreateSiteRequest{}, Status: 201},
+	"PUT /site":          {Summary: "Update site", Tags: tagSiteV2, Params: []openapi.Parameter{ifMatchParam}, Request: request.UpdateSiteRequest{}, Response: request.UpdateSiteRequest{}},
+	"DELETE /site":       {Summary: "Delete site", Tags: tagSiteV2, Request: request.DeleteSiteRequest{}},
+	"GET /reports/sites": {Summary: "Occupancy and revenue per site", Tags: tagSiteV2, Response: apiv2.SiteReports{}},
+
+	"GET /audit":        {Summary: "Query audit trail, newest first", Tags: tagAuditV2, Query: request.GetAuditLogRequest{}, Response: []response.AuditLogResponse{}},
+	"GET /audit/verify": {Summary: "Verify hash chain of audit trail", Tags: tagAuditV2, Response: response.VerifyAuditLogResponse{}},
+}
@@ -0,0 +1,33 @@
+package auditlog
+
+import (
+	UsecaseAuditLog "github.com/mhaikalla/parking-service-management-library/components/usecase/usecaseAuditLog"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
+
+	validation "github.com/go-playground/validator/v10"
+)
+
+type Handlers struct {
+	Config          *config.Config
+	Validator       validation.Validate
+	usecaseAuditLog UsecaseAuditLog.IUsecaseAuditLog
+}
+
+func NewAuditLogHandlers(
+	config *config.Config,
+	validator validation.Validate,
+	path string,
+) (handler *Handlers, err error) {
+	defer func() {
+		if r, ok := recover().(error); r != nil && ok {
+			err = r
+		}
+	}()
+	usecaseAuditLog := UsecaseAuditLog.NewAuditLogUsecase(audit.ForStorage(path))
+	return &Handlers{
+		Config:          config,
+		Validator:       validator,
+		usecaseAuditLog: usecaseAuditLog,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package auditlog
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/validator"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+)
+
+func (h *Handlers) GetAuditLog() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		resultValidation, errValidation := validator.ValidateGetListParams(h.Validator, bc)
+		if errValidation != nil {
+			return bc.JSON(errs.BadRequest, errValidation)
+		}
+
+		in := request.GetAuditLogRequest{
+			BaseGetListParams: *resultValidation,
+			Actor:             bc.QueryParam("actor"),
+			RequestId:         bc.QueryParam("request_id"),
+			Action:            bc.QueryParam("action"),
+			Entity:            bc.QueryParam("entity"),
+			EntityId:          bc.QueryParam("entity_id"),
+		}
+		// `from` and `to` given as RFC 3339 time
+		for param, dst := range map[string]*time.Time{"from": &in.From, "to": &in.To} {
+			value := bc.QueryParam(param)
+			if value == "" {
+				continue
+			}
+			t, errParse := time.Parse(time.RFC3339, value)
+			if errParse != nil {
+				return bc.JSON(errs.BadRequest, validator.InvalidParam(bc, param, "datetime"))
+			}
+			*dst = t
+		}
+
+		result, errResp := h.usecaseAuditLog.GetAuditLogs(bc, &in)
+		if errResp != nil {
+			log.Println(errResp)
+			errCode, _ := strconv.Atoi(errResp.Code)
+			return bc.JSON(errCode, errResp)
+		}
+
+		return bc.JSON(200, result)
+	}
+}
@@ -0,0 +1,49 @@
+package auditlog
+
+import (
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/envelope"
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/validator"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+func (h *Handlers) GetAuditLogV2() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		params, errValidation := validator.ValidateGetListParams(h.Validator, bc)
+		if errValidation != nil {
+			return envelope.Error(bc, errValidation, nil)
+		}
+
+		in := request.GetAuditLogRequest{
+			BaseGetListParams: *params,
+			Actor:             bc.QueryParam("actor"),
+			RequestId:         bc.QueryParam("request_id"),
+			Action:            bc.QueryParam("action"),
+			Entity:            bc.QueryParam("entity"),
+			EntityId:          bc.QueryParam("entity_id"),
+		}
+		// `from` and `to` given as RFC 3339 time
+		for param, dst := range map[string]*time.Time{"from": &in.From, "to": &in.To} {
+			value := bc.QueryParam(param)
+			if value == "" {
+				continue
+			}
+			t, errParse := time.Parse(time.RFC3339, value)
+			if errParse != nil {
+				return envelope.Error(bc, validator.InvalidParam(bc, param, "datetime"), nil)
+			}
+			*dst = t
+		}
+
+		result, errResp := h.usecaseAuditLog.GetAuditLogs(bc, &in)
+		if errResp != nil {
+			return envelope.Error(bc, errResp, nil)
+		}
+
+		return envelope.List(bc, result.Data, len(result.Data), *params, &result.Total)
+	}
+}
@@ -0,0 +1,23 @@
+package auditlog
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+func (h *Handlers) VerifyAuditLog() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		result, errResp := h.usecaseAuditLog.VerifyAuditLogs(bc)
+		if errResp != nil {
+			log.Println(errResp)
+			errCode, _ := strconv.Atoi(errResp.Code)
+			return bc.JSON(errCode, errResp)
+		}
+
+		return bc.JSON(200, result)
+	}
+}
@@ -0,0 +1,21 @@
+package auditlog
+
+import (
+	"net/http"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/envelope"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+func (h *Handlers) VerifyAuditLogV2() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		result, errResp := h.usecaseAuditLog.VerifyAuditLogs(bc)
+		if errResp != nil {
+			return envelope.Error(bc, errResp, nil)
+		}
+
+		return envelope.OK(bc, http.StatusOK, result)
+	}
+}
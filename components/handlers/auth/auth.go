@@ -0,0 +1,42 @@
+package auth
+
+import (
+	UsecaseAuth "github.com/mhaikalla/parking-service-management-library/components/usecase/usecaseAuth"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+	"github.com/mhaikalla/parking-service-management-library/pkg/jwt"
+
+	validation "github.com/go-playground/validator/v10"
+)
+
+type Handlers struct {
+	Config      *config.Config
+	Validator   validation.Validate
+	UsecaseAuth UsecaseAuth.IUsecaseAuth
+}
+
+func NewAuthHandlers(
+	config *config.Config,
+	validator validation.Validate,
+	path string,
+) (handler *Handlers, err error) {
+	defer func() {
+		if r, ok := recover().(error); r != nil && ok {
+			err = r
+		}
+	}()
+
+	usecaseAuth := UsecaseAuth.NewAuthUsecase(
+		file.NewFileSystem(path),
+		audit.ForStorage(path),
+		jwt.New(config.JWT, config.Secrets.JWT),
+		UsecaseAuth.NewTokenConfig(config.Auth.Token),
+	)
+
+	return &Handlers{
+		Config:      config,
+		Validator:   validator,
+		UsecaseAuth: usecaseAuth,
+	}, nil
+}
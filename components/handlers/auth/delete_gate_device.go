@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/validator"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+)
+
+func (h *Handlers) DeleteGateDevice() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		in := request.DeleteGateDeviceRequest{}
+		if err := bc.Load(&in); err != nil {
+			return bc.JSON(errs.BadRequest, errs.NewErrContext().
+				SetCode(errs.BadRequest).
+				SetError(err))
+		}
+
+		errValidateData := h.Validator.Struct(in)
+		if errValidateData != nil {
+			return bc.JSON(errs.BadRequest, validator.ValidationError(bc, errValidateData))
+		}
+
+		result, errResp := h.UsecaseAuth.DeleteGateDevice(bc, &in)
+		if errResp != nil {
+			log.Println(errResp)
+			errCode, _ := strconv.Atoi(errResp.Code)
+			return bc.JSON(errCode, errResp)
+		}
+
+		return bc.JSON(200, result)
+	}
+}
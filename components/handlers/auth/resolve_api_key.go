@@ -0,0 +1,21 @@
+package auth
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/pkg/router"
+)
+
+// ResolveApiKey resolver of `x-api-key` header used by router auth middleware.
+func (h *Handlers) ResolveApiKey() func(key string) (router.APIKeyIdentity, error) {
+	return func(key string) (router.APIKeyIdentity, error) {
+		apiKey, err := h.UsecaseAuth.ResolveApiKey(key)
+		if err != nil {
+			return router.APIKeyIdentity{}, err
+		}
+		return router.APIKeyIdentity{
+			Id:     apiKey.Id,
+			Name:   apiKey.Name,
+			SiteID: apiKey.SiteId,
+			Scopes: apiKey.Scopes,
+		}, nil
+	}
+}
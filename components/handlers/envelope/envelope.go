@@ -0,0 +1,85 @@
+// Package envelope write API v2 responses wrapped on apiv2.Envelope.
+package envelope
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/validator"
+	"github.com/mhaikalla/parking-service-management-library/components/models/apiv2"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+)
+
+// OK write `data` with http `status`.
+func OK(bc contexts.BearerContext, status int, data interface{}) error {
+	return bc.JSON(status, apiv2.Envelope{Data: data, Meta: meta(bc)})
+}
+
+// List write page of `count` items fetched with `params`, `total` sent when usecase counted all matched items.
+func List(bc contexts.BearerContext, data interface{}, count int, params request.BaseGetListParams, total *int) error {
+	m := meta(bc)
+	m.Limit = &params.Limit
+	m.Offset = &params.Offset
+	m.Count = &count
+	m.Total = total
+	return bc.JSON(http.StatusOK, apiv2.Envelope{Data: data, Meta: m})
+}
+
+// Error write `err` with http status taken from its code, `data` carry current state of entity such as on
+// version conflict. Error without valid http code sent as internal server error.
+func Error(bc contexts.BearerContext, err error, data interface{}) error {
+	status := http.StatusInternalServerError
+	message := err.Error()
+	var fields []errs.FieldError
+	if e, ok := err.(*errs.Errs); ok {
+		if code, errCode := strconv.Atoi(e.Code); errCode == nil && code >= 400 && code < 600 {
+			status = code
+		}
+		message = e.Message
+		fields = e.Errors
+	}
+	return write(bc, status, message, fields, data)
+}
+
+// BadRequest write error of body or params which can not be parsed.
+func BadRequest(bc contexts.BearerContext, err error) error {
+	return write(bc, http.StatusBadRequest, err.Error(), nil, nil)
+}
+
+// Invalid write error of request parsed but rejected by validation, listing each field failed.
+func Invalid(bc contexts.BearerContext, err error) error {
+	e := validator.ValidationError(bc, err)
+	return write(bc, http.StatusUnprocessableEntity, e.Message, e.Errors, nil)
+}
+
+func write(bc contexts.BearerContext, status int, message string, fields []errs.FieldError, data interface{}) error {
+	if message == "" {
+		message = http.StatusText(status)
+	}
+	return bc.JSON(status, apiv2.Envelope{
+		Data: data,
+		Meta: meta(bc),
+		Error: &apiv2.Error{
+			Status:  status,
+			Code:    Code(status),
+			Message: message,
+			Errors:  fields,
+		},
+	})
+}
+
+// Code machine readable code of http `status`, e.g. `not_found`.
+func Code(status int) string {
+	text := http.StatusText(status)
+	if text == "" {
+		return "error"
+	}
+	return strings.ReplaceAll(strings.ToLower(strings.ReplaceAll(text, "-", " ")), " ", "_")
+}
+
+func meta(bc contexts.BearerContext) apiv2.Meta {
+	return apiv2.Meta{RequestId: bc.GetRequestID()}
+}
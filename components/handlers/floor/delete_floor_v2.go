@@ -0,0 +1,32 @@
+package floor
+
+import (
+	"net/http"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/envelope"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+func (h *Handlers) DeleteFloorV2() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		in := request.DeleteFloorRequest{}
+		if err := bc.Load(&in); err != nil {
+			return envelope.BadRequest(bc, err)
+		}
+
+		errValidateData := h.Validator.Struct(in)
+		if errValidateData != nil {
+			return envelope.Invalid(bc, errValidateData)
+		}
+
+		_, errResp := h.usecaseFloor.DeleteFloor(bc, &in)
+		if errResp != nil {
+			return envelope.Error(bc, errResp, nil)
+		}
+
+		return envelope.OK(bc, http.StatusOK, nil)
+	}
+}
@@ -0,0 +1,34 @@
+package floor
+
+import (
+	UsecaseFloor "github.com/mhaikalla/parking-service-management-library/components/usecase/usecaseFloor"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+
+	validation "github.com/go-playground/validator/v10"
+)
+
+type Handlers struct {
+	Config       *config.Config
+	Validator    validation.Validate
+	usecaseFloor UsecaseFloor.IUsecaseFloor
+}
+
+func NewFloorHandlers(
+	config *config.Config,
+	validator validation.Validate,
+	path string,
+) (handler *Handlers, err error) {
+	defer func() {
+		if r, ok := recover().(error); r != nil && ok {
+			err = r
+		}
+	}()
+	usecaseFloor := UsecaseFloor.NewFloorUsecase(file.NewFileSystem(path), audit.ForStorage(path))
+	return &Handlers{
+		Config:       config,
+		Validator:    validator,
+		usecaseFloor: usecaseFloor,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package floor
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/envelope"
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/validator"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+func (h *Handlers) GetFloorV2() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		params, errValidation := validator.ValidateGetListParams(h.Validator, bc)
+		if errValidation != nil {
+			return envelope.Error(bc, errValidation, nil)
+		}
+
+		in := request.GetFloorRequest{
+			BaseGetListParams: *params,
+		}
+		result, errResp := h.usecaseFloor.GetFloors(bc, &in)
+		if errResp != nil {
+			return envelope.Error(bc, errResp, nil)
+		}
+
+		return envelope.List(bc, result.Data, len(result.Data), *params, nil)
+	}
+}
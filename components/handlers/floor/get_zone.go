@@ -0,0 +1,46 @@
+package floor
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/validator"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+)
+
+func (h *Handlers) GetZone() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		resultValidation, errValidation := validator.ValidateGetListParams(h.Validator, bc)
+		if errValidation != nil {
+			return bc.JSON(errs.BadRequest, errValidation)
+		}
+
+		in := request.GetZoneRequest{
+			BaseGetListParams: request.BaseGetListParams{
+				Search: resultValidation.Search,
+				Limit:  resultValidation.Limit,
+				Offset: resultValidation.Offset,
+			},
+		}
+		if floorId := bc.QueryParam("floor_id"); len(floorId) > 0 {
+			id, errConv := strconv.Atoi(floorId)
+			if errConv != nil {
+				return bc.JSON(errs.BadRequest, validator.InvalidParam(bc, "floor_id", "numeric"))
+			}
+			in.FloorId = id
+		}
+
+		result, errResp := h.usecaseFloor.GetZones(bc, &in)
+		if errResp != nil {
+			log.Println(errResp)
+			errCode, _ := strconv.Atoi(errResp.Code)
+			return bc.JSON(errCode, errResp)
+		}
+
+		return bc.JSON(200, result)
+	}
+}
@@ -0,0 +1,39 @@
+package floor
+
+import (
+	"strconv"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/envelope"
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/validator"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+func (h *Handlers) GetZoneV2() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		params, errValidation := validator.ValidateGetListParams(h.Validator, bc)
+		if errValidation != nil {
+			return envelope.Error(bc, errValidation, nil)
+		}
+
+		in := request.GetZoneRequest{
+			BaseGetListParams: *params,
+		}
+		if floorId := bc.QueryParam("floor_id"); len(floorId) > 0 {
+			id, errConv := strconv.Atoi(floorId)
+			if errConv != nil {
+				return envelope.Error(bc, validator.InvalidParam(bc, "floor_id", "numeric"), nil)
+			}
+			in.FloorId = id
+		}
+
+		result, errResp := h.usecaseFloor.GetZones(bc, &in)
+		if errResp != nil {
+			return envelope.Error(bc, errResp, nil)
+		}
+
+		return envelope.List(bc, result.Data, len(result.Data), *params, nil)
+	}
+}
@@ -0,0 +1,43 @@
+package floor
+
+import (
+	"net/http"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/envelope"
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/validator"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+func (h *Handlers) UpdateFloorV2() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		in := request.UpdateFloorRequest{}
+		if err := bc.Load(&in); err != nil {
+			return envelope.BadRequest(bc, err)
+		}
+
+		errValidateData := h.Validator.Struct(in)
+		if errValidateData != nil {
+			return envelope.Invalid(bc, errValidateData)
+		}
+
+		version, errVersion := validator.ResolveVersion(bc, in.Version, true)
+		if errVersion != nil {
+			return envelope.Error(bc, errVersion, nil)
+		}
+		in.Version = version
+
+		result, errResp := h.usecaseFloor.UpdateFloor(bc, in)
+		if errResp != nil {
+			// version conflict carry current data so client can merge and retry
+			if result != nil {
+				return envelope.Error(bc, errResp, result.Data)
+			}
+			return envelope.Error(bc, errResp, nil)
+		}
+
+		return envelope.OK(bc, http.StatusOK, result.Data)
+	}
+}
@@ -0,0 +1,16 @@
+package health
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+// GetHealthz liveness, answered as long as process serving request.
+func (h *Handlers) GetHealthz() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		return bc.JSON(200, response.HealthResponse{Status: constant.HealthUp})
+	}
+}
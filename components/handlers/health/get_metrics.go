@@ -0,0 +1,26 @@
+package health
+
+import (
+	"bytes"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/metrics"
+)
+
+// GetMetrics every metric on Prometheus text format, occupancy gauges recomputed on each scrape.
+func (h *Handlers) GetMetrics() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		// stale occupancy still better than no metrics at all
+		if errResp := h.usecaseFloor.RefreshOccupancyMetrics(); errResp != nil {
+			bc.GetLogger().Warn(errResp)
+		}
+
+		buff := &bytes.Buffer{}
+		if err := metrics.Default.WriteText(buff); err != nil {
+			return err
+		}
+		return bc.Blob(200, metrics.ContentType, buff.Bytes())
+	}
+}
@@ -0,0 +1,20 @@
+package health
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+// GetReadyz readiness, 503 when any dependency check down.
+func (h *Handlers) GetReadyz() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		result := h.usecaseHealth.CheckReadiness(bc)
+		if result.Status != constant.HealthUp {
+			return bc.JSON(503, result)
+		}
+
+		return bc.JSON(200, result)
+	}
+}
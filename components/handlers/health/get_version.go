@@ -0,0 +1,13 @@
+package health
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+func (h *Handlers) GetVersion() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		return bc.JSON(200, h.usecaseHealth.GetVersion(bc))
+	}
+}
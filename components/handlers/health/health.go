@@ -0,0 +1,41 @@
+package health
+
+import (
+	UsecaseFloor "github.com/mhaikalla/parking-service-management-library/components/usecase/usecaseFloor"
+	UsecaseHealth "github.com/mhaikalla/parking-service-management-library/components/usecase/usecaseHealth"
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
+	database "github.com/mhaikalla/parking-service-management-library/pkg/database"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// Path of probes and metrics, served without auth outside of API prefix.
+const (
+	HealthzPath = "/healthz"
+	ReadyzPath  = "/readyz"
+	VersionPath = "/version"
+	MetricsPath = "/metrics"
+)
+
+type Handlers struct {
+	Config        *config.Config
+	usecaseHealth UsecaseHealth.IUsecaseHealth
+	usecaseFloor  UsecaseFloor.IUsecaseFloor
+}
+
+func NewHealthHandlers(
+	config *config.Config,
+	path string,
+) (handler *Handlers, err error) {
+	defer func() {
+		if r, ok := recover().(error); r != nil && ok {
+			err = r
+		}
+	}()
+	usecaseHealth := UsecaseHealth.NewHealthUsecase(UsecaseHealth.StoragePath(path), database.NewDBChecker(config.Raw))
+	usecaseFloor := UsecaseFloor.NewFloorUsecase(file.NewFileSystem(path))
+	return &Handlers{
+		Config:        config,
+		usecaseHealth: usecaseHealth,
+		usecaseFloor:  usecaseFloor,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package parking
+
+import (
+	"net/http"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/envelope"
+	"github.com/mhaikalla/parking-service-management-library/components/models/apiv2"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+func (h *Handlers) GetCountParkingDataV2() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		in := apiv2.CountParkedVehiclesRequest{
+			Type: bc.QueryParam("type"),
+		}
+		errValidateData := h.Validator.Struct(in)
+		if errValidateData != nil {
+			return envelope.Invalid(bc, errValidateData)
+		}
+
+		inV1 := in.ToV1()
+		result, errResp := h.UsecaseParking.GetCountParkingData(bc, &inV1)
+		if errResp != nil {
+			return envelope.Error(bc, errResp, nil)
+		}
+
+		return envelope.OK(bc, http.StatusOK, apiv2.ParkedVehicleCount{VehicleCount: result.JumlahKendaraan})
+	}
+}
@@ -0,0 +1,21 @@
+package parking
+
+import (
+	"strconv"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+func (h *Handlers) GetParkingQueue() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		result, errResp := h.UsecaseParking.GetParkingQueue(bc)
+		if errResp != nil {
+			errCode, _ := strconv.Atoi(errResp.Code)
+			return bc.JSON(errCode, errResp)
+		}
+
+		return bc.JSON(200, result)
+	}
+}
@@ -2,20 +2,24 @@ package parking
 
 import (
 	UsecaseParking "github.com/mhaikalla/parking-service-management-library/components/usecase/usecaseParking"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
 	"github.com/mhaikalla/parking-service-management-library/pkg/file"
 
 	validation "github.com/go-playground/validator/v10"
 )
 
 type Handlers struct {
-	Config         map[string]map[string]interface{}
+	Config         *config.Config
 	Validator      validation.Validate
 	UsecaseParking UsecaseParking.IUsecaseParking
+	// Settings queue of usecase, replaced on config reload.
+	Settings *UsecaseParking.Settings
 }
 
 // NewMenuHandlers create a new `MenuHandlers` with `db` provided.
 func NewParkingHandlers(
-	config map[string]map[string]interface{},
+	config *config.Config,
 	validator validation.Validate,
 	path string,
 ) (handler *Handlers, err error) {
@@ -25,11 +29,17 @@ func NewParkingHandlers(
 		}
 	}()
 
-	usecaseParking := UsecaseParking.NewParkingUsecase(file.NewFileSystem(path))
+	settings := UsecaseParking.NewSettings(UsecaseParking.NewQueueConfig(config.Parking.Queue))
+	usecaseParking := UsecaseParking.NewParkingUsecase(
+		file.NewFileSystem(path),
+		audit.ForStorage(path),
+		settings,
+	)
 
 	return &Handlers{
 		Config:         config,
 		Validator:      validator,
 		UsecaseParking: usecaseParking,
+		Settings:       settings,
 	}, nil
 }
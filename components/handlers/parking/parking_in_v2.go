@@ -0,0 +1,38 @@
+package parking
+
+import (
+	"net/http"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/envelope"
+	"github.com/mhaikalla/parking-service-management-library/components/models/apiv2"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+func (h *Handlers) SetParkingInV2() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		in := apiv2.ParkingInRequest{}
+		if err := bc.Load(&in); err != nil {
+			return envelope.BadRequest(bc, err)
+		}
+
+		errValidateData := h.Validator.Struct(in)
+		if errValidateData != nil {
+			return envelope.Invalid(bc, errValidateData)
+		}
+
+		inV1 := in.ToV1()
+		result, errResp := h.UsecaseParking.SetParkingIn(bc, &inV1)
+		if errResp != nil {
+			return envelope.Error(bc, errResp, nil)
+		}
+
+		// no parking lot available, vehicle waiting on queue
+		if queued, ok := result.Data.(response.ParkingQueueResponse); ok {
+			return envelope.OK(bc, http.StatusAccepted, apiv2.QueueEntryFrom(queued))
+		}
+		return envelope.OK(bc, http.StatusCreated, apiv2.ParkingEntryFrom(in))
+	}
+}
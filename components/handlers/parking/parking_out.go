@@ -3,6 +3,7 @@ package parking
 import (
 	"strconv"
 
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/validator"
 	"github.com/mhaikalla/parking-service-management-library/components/models/request"
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
 	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
@@ -21,9 +22,7 @@ func (h *Handlers) SetParkingOut() func(i interface{}) error {
 
 		errValidateData := h.Validator.Struct(in)
 		if errValidateData != nil {
-			return bc.JSON(errs.BadRequest, errs.NewErrContext().
-				SetCode(errs.BadRequest).
-				SetError(errValidateData))
+			return bc.JSON(errs.BadRequest, validator.ValidationError(bc, errValidateData))
 		}
 
 		result, errResp := h.UsecaseParking.SetParkingOut(bc, &in)
@@ -0,0 +1,36 @@
+package parkinglot
+
+import (
+	"net/http"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/envelope"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+func (h *Handlers) CreateBulkParkingLotV2() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		in := request.CreateBulkParkingLotRequest{}
+		if err := bc.Load(&in); err != nil {
+			return envelope.BadRequest(bc, err)
+		}
+
+		errValidateData := h.Validator.Struct(in)
+		if errValidateData != nil {
+			return envelope.Invalid(bc, errValidateData)
+		}
+
+		result, errResp := h.usecaseParkingLot.CreateBulkParkingLots(bc, in)
+		if errResp != nil {
+			// failed bulk request still report result of every item
+			if result != nil {
+				return envelope.Error(bc, errResp, result.Data)
+			}
+			return envelope.Error(bc, errResp, nil)
+		}
+
+		return envelope.OK(bc, http.StatusCreated, result.Data)
+	}
+}
@@ -0,0 +1,35 @@
+package parkinglot
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/validator"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+)
+
+func (h *Handlers) GetDetailMaintenanceWindow() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		// Parse input request
+		in := request.GetDetailMaintenanceWindowRequest{
+			MaintenanceWindowId: bc.Param("id"),
+		}
+		errValidateData := h.Validator.Struct(in)
+		if errValidateData != nil {
+			return bc.JSON(errs.BadRequest, validator.ValidationError(bc, errValidateData))
+		}
+		result, errResp := h.usecaseParkingLot.GetDetailMaintenanceWindow(bc, &in)
+		if errResp != nil {
+			log.Println(errResp)
+			errCode, _ := strconv.Atoi(errResp.Code)
+			return bc.JSON(errCode, errResp)
+		}
+
+		validator.SetETag(bc, result.Version)
+		return bc.JSON(200, result)
+	}
+}
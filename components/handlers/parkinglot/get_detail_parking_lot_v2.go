@@ -0,0 +1,32 @@
+package parkinglot
+
+import (
+	"net/http"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/envelope"
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/validator"
+	"github.com/mhaikalla/parking-service-management-library/components/models/apiv2"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+func (h *Handlers) GetDetailParkingLotV2() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		in := request.GetDetailParkingLotRequest{
+			ParkingLotId: bc.Param("id"),
+		}
+		errValidateData := h.Validator.Struct(in)
+		if errValidateData != nil {
+			return envelope.Error(bc, validator.ValidationError(bc, errValidateData), nil)
+		}
+		result, errResp := h.usecaseParkingLot.GetDetailParkingLot(bc, &in)
+		if errResp != nil {
+			return envelope.Error(bc, errResp, nil)
+		}
+
+		validator.SetETag(bc, result.Version)
+		return envelope.OK(bc, http.StatusOK, apiv2.ParkingLotFrom(*result))
+	}
+}
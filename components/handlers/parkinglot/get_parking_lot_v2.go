@@ -0,0 +1,30 @@
+package parkinglot
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/envelope"
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/validator"
+	"github.com/mhaikalla/parking-service-management-library/components/models/apiv2"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+func (h *Handlers) GetParkingLotV2() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		params, errValidation := validator.ValidateGetListParams(h.Validator, bc)
+		if errValidation != nil {
+			return envelope.Error(bc, errValidation, nil)
+		}
+
+		in := request.GetParkingLotRequest{
+			BaseGetListParams: *params,
+		}
+		result, errResp := h.usecaseParkingLot.GetParkingLots(bc, &in)
+		if errResp != nil {
+			return envelope.Error(bc, errResp, nil)
+		}
+
+		return envelope.List(bc, apiv2.ParkingLotsFrom(result.Data), len(result.Data), *params, nil)
+	}
+}
@@ -2,19 +2,21 @@ package parkinglot
 
 import (
 	UsecaseParkingLot "github.com/mhaikalla/parking-service-management-library/components/usecase/usecaseParkingLot"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
 	"github.com/mhaikalla/parking-service-management-library/pkg/file"
 
 	validation "github.com/go-playground/validator/v10"
 )
 
 type Handlers struct {
-	Config            map[string]map[string]interface{}
+	Config            *config.Config
 	Validator         validation.Validate
 	usecaseParkingLot UsecaseParkingLot.IUsecaseParkingLot
 }
 
 func NewParkingLotHandlers(
-	config map[string]map[string]interface{},
+	config *config.Config,
 	validator validation.Validate,
 	path string,
 ) (handler *Handlers, err error) {
@@ -23,7 +25,7 @@ func NewParkingLotHandlers(
 			err = r
 		}
 	}()
-	usecaseParkingLot := UsecaseParkingLot.NewParkingLotUsecase(file.NewFileSystem(path))
+	usecaseParkingLot := UsecaseParkingLot.NewParkingLotUsecase(file.NewFileSystem(path), audit.ForStorage(path))
 	return &Handlers{
 		Config:            config,
 		Validator:         validator,
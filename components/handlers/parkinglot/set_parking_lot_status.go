@@ -0,0 +1,47 @@
+package parkinglot
+
+import (
+	"strconv"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/validator"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+)
+
+func (h *Handlers) SetParkingLotStatus() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		in := request.SetParkingLotStatusRequest{}
+		if err := bc.Load(&in); err != nil {
+			return bc.JSON(errs.BadRequest, errs.NewErrContext().
+				SetCode(errs.BadRequest).
+				SetError(err))
+		}
+
+		errValidateData := h.Validator.Struct(in)
+		if errValidateData != nil {
+			return bc.JSON(errs.BadRequest, validator.ValidationError(bc, errValidateData))
+		}
+
+		version, errVersion := validator.ResolveVersion(bc, in.Version, false)
+		if errVersion != nil {
+			errCode, _ := strconv.Atoi(errVersion.Code)
+			return bc.JSON(errCode, errVersion)
+		}
+		in.Version = version
+
+		result, errResp := h.usecaseParkingLot.SetParkingLotStatus(bc, in)
+		if errResp != nil {
+			errCode, _ := strconv.Atoi(errResp.Code)
+			// version conflict carry current data so client can merge and retry
+			if result != nil {
+				return bc.JSON(errCode, result)
+			}
+			return bc.JSON(errCode, errResp)
+		}
+
+		return bc.JSON(200, result)
+	}
+}
@@ -0,0 +1,44 @@
+package parkinglot
+
+import (
+	"net/http"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/envelope"
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/validator"
+	"github.com/mhaikalla/parking-service-management-library/components/models/apiv2"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+func (h *Handlers) SetParkingLotStatusV2() func(i interface{}) error {
+	return func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+
+		in := request.SetParkingLotStatusRequest{}
+		if err := bc.Load(&in); err != nil {
+			return envelope.BadRequest(bc, err)
+		}
+
+		errValidateData := h.Validator.Struct(in)
+		if errValidateData != nil {
+			return envelope.Invalid(bc, errValidateData)
+		}
+
+		version, errVersion := validator.ResolveVersion(bc, in.Version, false)
+		if errVersion != nil {
+			return envelope.Error(bc, errVersion, nil)
+		}
+		in.Version = version
+
+		result, errResp := h.usecaseParkingLot.SetParkingLotStatus(bc, in)
+		if errResp != nil {
+			// version conflict carry current data so client can merge and retry
+			if result != nil {
+				return envelope.Error(bc, errResp, apiv2.ParkingLotData(result.Data))
+			}
+			return envelope.Error(bc, errResp, nil)
+		}
+
+		return envelope.OK(bc, http.StatusOK, apiv2.ParkingLotData(result.Data))
+	}
+}
@@ -0,0 +1,34 @@
+package site
+
+import (
+	UsecaseSite "github.com/mhaikalla/parking-service-management-library/components/usecase/usecaseSite"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+
+	validation "github.com/go-playground/validator/v10"
+)
+
+type Handlers struct {
+	Config      *config.Config
+	Validator   validation.Validate
+	usecaseSite UsecaseSite.IUsecaseSite
+}
+
+func NewSiteHandlers(
+	config *config.Config,
+	validator validation.Validate,
+	path string,
+) (handler *Handlers, err error) {
+	defer func() {
+		if r, ok := recover().(error); r != nil && ok {
+			err = r
+		}
+	}()
+	usecaseSite := UsecaseSite.NewSiteUsecase(file.NewFileSystem(path), audit.ForStorage(path))
+	return &Handlers{
+		Config:      config,
+		Validator:   validator,
+		usecaseSite: usecaseSite,
+	}, nil
+}
@@ -5,7 +5,6 @@ import (
 
 	"github.com/mhaikalla/parking-service-management-library/components/models/request"
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
-	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
 
 	validation "github.com/go-playground/validator/v10"
 )
@@ -19,19 +18,15 @@ func ValidateGetListParams(validatorRequest validation.Validate, bc contexts.Bea
 	if len(limit) > 0 {
 		l, errLimitVal := strconv.Atoi(limit)
 		if errLimitVal != nil {
-			return nil, errs.NewErrContext().
-				SetCode(errs.BadRequest).
-				SetMessage("Invalid Params limit")
+			return nil, InvalidParam(bc, "limit", "numeric")
 		}
 		limitVal = l
 	}
 
 	if len(offset) > 0 {
-		o, errLimitVal := strconv.Atoi(offset)
-		if errLimitVal != nil {
-			return nil, errs.NewErrContext().
-				SetCode(errs.BadRequest).
-				SetMessage("Invalid Params limit")
+		o, errOffsetVal := strconv.Atoi(offset)
+		if errOffsetVal != nil {
+			return nil, InvalidParam(bc, "offset", "numeric")
 		}
 		offsetVal = o
 	}
@@ -43,9 +38,7 @@ func ValidateGetListParams(validatorRequest validation.Validate, bc contexts.Bea
 	}
 	errValidate := validatorRequest.Struct(inputs)
 	if errValidate != nil {
-		return nil, errs.NewErrContext().
-			SetCode(errs.BadRequest).
-			SetMessage(errs.MessageByCode[strconv.Itoa(errs.BadRequest)])
+		return nil, ValidationError(bc, errValidate)
 	}
 	return &inputs, nil
 
@@ -0,0 +1,35 @@
+package validator
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	validatorRequest "github.com/mhaikalla/parking-service-management-library/pkg/validator"
+)
+
+// Lang language of error message asked by client through `Accept-Language` header.
+func Lang(bc contexts.BearerContext) string {
+	return errs.ParseLang(bc.Request().Header.Get("Accept-Language"))
+}
+
+// ValidationError bad request listing each field failed on `err` returned by `Validate.Struct`.
+func ValidationError(bc contexts.BearerContext, err error) *errs.Errs {
+	lang := Lang(bc)
+	return errs.NewErrContext().
+		SetCode(errs.BadRequest).
+		SetLang(lang).
+		SetError(err).
+		SetFieldErrors(validatorRequest.FieldErrors(err, lang))
+}
+
+// InvalidParam bad request of `field` failed on `rule` outside of `Validate.Struct`, such as query param not parsed.
+func InvalidParam(bc contexts.BearerContext, field, rule string) *errs.Errs {
+	lang := Lang(bc)
+	return errs.NewErrContext().
+		SetCode(errs.BadRequest).
+		SetLang(lang).
+		SetFieldErrors([]errs.FieldError{{
+			Field:   field,
+			Rule:    rule,
+			Message: errs.FieldMessage(lang, field, rule, "", false),
+		}})
+}
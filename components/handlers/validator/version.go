@@ -0,0 +1,43 @@
+package validator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+)
+
+// ETag format entity version as ETag header value.
+func ETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// SetETag send entity version as `ETag` header.
+func SetETag(bc contexts.BearerContext, version int) {
+	bc.Response().Header().Set("ETag", ETag(version))
+}
+
+// ResolveVersion take version client expect from `If-Match` header, falling back to `version` on body.
+// `If-Match: *` skip version check. When `required` update without any of them rejected.
+func ResolveVersion(bc contexts.BearerContext, bodyVersion *int, required bool) (*int, *errs.Errs) {
+	ifMatch := strings.TrimSpace(bc.Request().Header.Get("If-Match"))
+	if ifMatch == "*" {
+		return nil, nil
+	}
+	if ifMatch != "" {
+		version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`))
+		if err != nil {
+			return nil, errs.NewErrContext().
+				SetCode(errs.BadRequest).
+				SetMessage("Invalid If-Match Header")
+		}
+		return &version, nil
+	}
+	if bodyVersion == nil && required {
+		return nil, errs.NewErrContext().
+			SetCode(errs.PreconditionRequired).
+			SetMessage("If-Match Header Or Version Required")
+	}
+	return bodyVersion, nil
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/validator"
 	"github.com/mhaikalla/parking-service-management-library/components/models/request"
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
 	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
@@ -19,9 +20,7 @@ func (h *Handlers) GetDetailVehicle() func(i interface{}) error {
 		}
 		errValidateData := h.Validator.Struct(in)
 		if errValidateData != nil {
-			return bc.JSON(errs.BadRequest, errs.NewErrContext().
-				SetCode(errs.BadRequest).
-				SetError(errValidateData))
+			return bc.JSON(errs.BadRequest, validator.ValidationError(bc, errValidateData))
 		}
 		result, errResp := h.usecaseVehicle.GetDetailVehicle(bc, &in)
 		if errResp != nil {
@@ -30,6 +29,7 @@ func (h *Handlers) GetDetailVehicle() func(i interface{}) error {
 			return bc.JSON(errCode, errResp)
 		}
 
+		validator.SetETag(bc, result.Version)
 		return bc.JSON(200, result)
 	}
 }
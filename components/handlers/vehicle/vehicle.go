@@ -2,19 +2,21 @@ package vehicle
 
 import (
 	UsecaseVehicle "github.com/mhaikalla/parking-service-management-library/components/usecase/usecaseVehicle"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
 	"github.com/mhaikalla/parking-service-management-library/pkg/file"
 
 	validation "github.com/go-playground/validator/v10"
 )
 
 type Handlers struct {
-	Config         map[string]map[string]interface{}
+	Config         *config.Config
 	Validator      validation.Validate
 	usecaseVehicle UsecaseVehicle.IUsecaseVehicle
 }
 
 func NewVehicleHandlers(
-	config map[string]map[string]interface{},
+	config *config.Config,
 	validator validation.Validate,
 	path string,
 ) (handler *Handlers, err error) {
@@ -23,7 +25,7 @@ func NewVehicleHandlers(
 			err = r
 		}
 	}()
-	usecaseVehicle := UsecaseVehicle.NewVehicleUsecase(file.NewFileSystem(path))
+	usecaseVehicle := UsecaseVehicle.NewVehicleUsecase(file.NewFileSystem(path), audit.ForStorage(path))
 	return &Handlers{
 		Config:         config,
 		Validator:      validator,
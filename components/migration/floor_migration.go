@@ -0,0 +1,109 @@
+package migration
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// RunFloorMigration turn distinct free text `ParkingLot.Floor` of each site into floor records
+// and link the lots to it. New floors get level by floor name order on top of existing levels,
+// lots already linked to a floor are left as is so migration safe to run on every start.
+func RunFloorMigration(fs file.IFileSystem) error {
+	if !fs.IsFileExisting(models.ParkingLotTableName) {
+		return nil
+	}
+	parkingLotData := []models.ParkingLot{}
+	floorData := []models.Floor{}
+	if err := file.LoadTable(fs, models.ParkingLotTableName, &parkingLotData); err != nil {
+		return err
+	}
+	if fs.IsFileExisting(models.FloorTableName) {
+		if err := file.LoadTable(fs, models.FloorTableName, &floorData); err != nil {
+			return err
+		}
+	}
+
+	floorKey := func(siteId int, name string) string {
+		return fmt.Sprintf("%d/%s", siteId, strings.ToLower(name))
+	}
+	floorIds := map[string]int{}
+	maxLevel := map[int]int{}
+	for _, fl := range floorData {
+		if fl.DeletedAt != nil {
+			continue
+		}
+		floorIds[floorKey(fl.SiteId, fl.Name)] = fl.Id
+		if fl.Level > maxLevel[fl.SiteId] {
+			maxLevel[fl.SiteId] = fl.Level
+		}
+	}
+
+	unlinked := false
+	newFloors := map[int][]string{}
+	for _, pl := range parkingLotData {
+		if pl.FloorId != 0 || pl.Floor == "" {
+			continue
+		}
+		unlinked = true
+		key := floorKey(pl.SiteId, pl.Floor)
+		if _, ok := floorIds[key]; ok {
+			continue
+		}
+		floorIds[key] = 0
+		newFloors[pl.SiteId] = append(newFloors[pl.SiteId], pl.Floor)
+	}
+	if !unlinked {
+		return nil
+	}
+
+	siteIds := []int{}
+	for siteId := range newFloors {
+		siteIds = append(siteIds, siteId)
+	}
+	sort.Ints(siteIds)
+
+	dateNow := time.Now().UTC()
+	for _, siteId := range siteIds {
+		names := newFloors[siteId]
+		sort.Strings(names)
+		for i, name := range names {
+			floorData = append(floorData, models.Floor{
+				BaseEntity: models.BaseEntity{
+					Id:        len(floorData) + 1,
+					CreatedAt: dateNow,
+					UpdatedAt: dateNow,
+					DeletedAt: nil,
+				},
+				SiteId: siteId,
+				Name:   name,
+				Level:  maxLevel[siteId] + i + 1,
+			})
+			floorIds[floorKey(siteId, name)] = len(floorData)
+		}
+	}
+
+	for i, pl := range parkingLotData {
+		if pl.FloorId != 0 || pl.Floor == "" {
+			continue
+		}
+		parkingLotData[i].FloorId = floorIds[floorKey(pl.SiteId, pl.Floor)]
+	}
+
+	if !fs.IsFileExisting(models.FloorTableName) {
+		if _, err := fs.CreateFile(models.FloorTableName); err != nil {
+			return err
+		}
+	}
+	if _, err := fs.SaveData(models.FloorTableName, floorData); err != nil {
+		return err
+	}
+	if _, err := fs.SaveData(models.ParkingLotTableName, parkingLotData); err != nil {
+		return err
+	}
+	return nil
+}
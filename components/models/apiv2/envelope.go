@@ -0,0 +1,29 @@
+// Package apiv2 models of `/api/v2/parking-management`. Fields named in English snake_case and money sent as
+// number with its currency, v1 model reused as is where it already follow those rules.
+package apiv2
+
+import "github.com/mhaikalla/parking-service-management-library/pkg/errs"
+
+// Envelope every v2 response, `data` null on error and `error` null on success.
+type Envelope struct {
+	Data  interface{} `json:"data"`
+	Meta  Meta        `json:"meta"`
+	Error *Error      `json:"error"`
+}
+
+// Meta about request and page of list response.
+type Meta struct {
+	RequestId string `json:"request_id,omitempty"`
+	Limit     *int   `json:"limit,omitempty"`
+	Offset    *int   `json:"offset,omitempty"`
+	Count     *int   `json:"count,omitempty"`
+	Total     *int   `json:"total,omitempty"`
+}
+
+// Error reason of failed request.
+type Error struct {
+	Status  int               `json:"status"`
+	Code    string            `json:"code"`
+	Message string            `json:"message"`
+	Errors  []errs.FieldError `json:"errors,omitempty"`
+}
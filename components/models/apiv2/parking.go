@@ -0,0 +1,136 @@
+package apiv2
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+)
+
+type ParkingInRequest struct {
+	PlateNumber string `json:"plate_number" validate:"required"`
+	Color       string `json:"color" validate:"required"`
+	Type        string `json:"type" validate:"required"`
+}
+
+type ParkingOutRequest struct {
+	PlateNumber string `json:"plate_number" validate:"required"`
+}
+
+type CancelParkingQueueRequest struct {
+	PlateNumber string `json:"plate_number" validate:"required"`
+}
+
+// GetParkedVehiclesRequest filter read from query string.
+type GetParkedVehiclesRequest struct {
+	Color string `json:"color" validate:"required"`
+	Type  string `json:"type"`
+}
+
+// CountParkedVehiclesRequest filter read from query string.
+type CountParkedVehiclesRequest struct {
+	Type string `json:"type" validate:"required"`
+}
+
+// ParkingEntry vehicle parked on parking in.
+type ParkingEntry struct {
+	PlateNumber string `json:"plate_number"`
+	Color       string `json:"color"`
+	Type        string `json:"type"`
+	Status      string `json:"status"`
+}
+
+// QueueEntry vehicle waiting for parking lot.
+type QueueEntry struct {
+	Id                int        `json:"id"`
+	PlateNumber       string     `json:"plate_number"`
+	Color             string     `json:"color"`
+	Type              string     `json:"type"`
+	Status            string     `json:"status"`
+	QueuePosition     int        `json:"queue_position"`
+	OfferedParkingLot string     `json:"offered_parking_lot,omitempty"`
+	OfferExpiresAt    *time.Time `json:"offer_expires_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+// ParkingReceipt fee charged on parking out.
+type ParkingReceipt struct {
+	PlateNumber string    `json:"plate_number"`
+	Amount      int       `json:"amount"`
+	Currency    string    `json:"currency"`
+	EnteredAt   time.Time `json:"entered_at"`
+	ExitedAt    time.Time `json:"exited_at"`
+}
+
+type ParkedVehicles struct {
+	PlateNumbers []string `json:"plate_numbers"`
+}
+
+type ParkedVehicleCount struct {
+	VehicleCount int `json:"vehicle_count"`
+}
+
+func (r ParkingInRequest) ToV1() request.ParkingInRequest {
+	return request.ParkingInRequest{PlatNomor: r.PlateNumber, Warna: r.Color, Tipe: r.Type}
+}
+
+func (r ParkingOutRequest) ToV1() request.ParkingOutRequest {
+	return request.ParkingOutRequest{PlatNomor: r.PlateNumber}
+}
+
+func (r CancelParkingQueueRequest) ToV1() request.CancelParkingQueueRequest {
+	return request.CancelParkingQueueRequest{PlatNomor: r.PlateNumber}
+}
+
+func (r GetParkedVehiclesRequest) ToV1() request.GetParkingData {
+	return request.GetParkingData{Warna: r.Color, Tipe: r.Type}
+}
+
+func (r CountParkedVehiclesRequest) ToV1() request.GetCountParkingData {
+	return request.GetCountParkingData{Tipe: r.Type}
+}
+
+func ParkingEntryFrom(req ParkingInRequest) ParkingEntry {
+	return ParkingEntry{
+		PlateNumber: req.PlateNumber,
+		Color:       req.Color,
+		Type:        req.Type,
+		Status:      constant.QueueStatusName[constant.QueueParked],
+	}
+}
+
+func QueueEntryFrom(q response.ParkingQueueResponse) QueueEntry {
+	return QueueEntry{
+		Id:                q.Id,
+		PlateNumber:       q.PlatNomor,
+		Color:             q.Warna,
+		Type:              q.Tipe,
+		Status:            q.Status,
+		QueuePosition:     q.QueuePosition,
+		OfferedParkingLot: q.OfferedParkingLot,
+		OfferExpiresAt:    q.OfferExpiredAt,
+		CreatedAt:         q.CreatedAt,
+	}
+}
+
+func QueueEntriesFrom(queue []response.ParkingQueueResponse) []QueueEntry {
+	entries := make([]QueueEntry, 0, len(queue))
+	for _, q := range queue {
+		entries = append(entries, QueueEntryFrom(q))
+	}
+	return entries
+}
+
+// ParkingReceiptFrom receipt of v1 parking out, amount sent by v1 as decimal string.
+func ParkingReceiptFrom(out response.ParkingOutResponse) ParkingReceipt {
+	amount, _ := strconv.Atoi(out.JumlahBayar)
+	return ParkingReceipt{
+		PlateNumber: out.PlatNomor,
+		Amount:      amount,
+		Currency:    constant.Currency,
+		EnteredAt:   out.TanggalMasuk,
+		ExitedAt:    out.TanggalKeluar,
+	}
+}
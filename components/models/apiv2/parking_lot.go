@@ -0,0 +1,55 @@
+package apiv2
+
+import (
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+)
+
+type ParkingLot struct {
+	Id           int       `json:"id"`
+	SiteId       int       `json:"site_id"`
+	Name         string    `json:"name"`
+	Floor        string    `json:"floor"`
+	FloorId      int       `json:"floor_id"`
+	ZoneId       int       `json:"zone_id"`
+	IsOccupied   bool      `json:"is_occupied"`
+	Status       string    `json:"status"`
+	StatusReason string    `json:"status_reason"`
+	Version      int       `json:"version"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+func ParkingLotFrom(pl response.GetDetailParkingLotResponse) ParkingLot {
+	return ParkingLot{
+		Id:           pl.Id,
+		SiteId:       pl.SiteId,
+		Name:         pl.Name,
+		Floor:        pl.Floor,
+		FloorId:      pl.FloorId,
+		ZoneId:       pl.ZoneId,
+		IsOccupied:   pl.IsParked,
+		Status:       pl.Status,
+		StatusReason: pl.StatusReason,
+		Version:      pl.Version,
+		CreatedAt:    pl.CreatedAt,
+		UpdatedAt:    pl.UpdatedAt,
+	}
+}
+
+func ParkingLotsFrom(lots []response.GetDetailParkingLotResponse) []ParkingLot {
+	result := make([]ParkingLot, 0, len(lots))
+	for _, pl := range lots {
+		result = append(result, ParkingLotFrom(pl))
+	}
+	return result
+}
+
+// ParkingLotData v2 form of parking lot carried on v1 message response data, other data returned as is.
+func ParkingLotData(data interface{}) interface{} {
+	if pl, ok := data.(response.GetDetailParkingLotResponse); ok {
+		return ParkingLotFrom(pl)
+	}
+	return data
+}
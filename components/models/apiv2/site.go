@@ -0,0 +1,49 @@
+package apiv2
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+)
+
+type SiteReport struct {
+	SiteId                int    `json:"site_id,omitempty"`
+	Code                  string `json:"code,omitempty"`
+	Name                  string `json:"name,omitempty"`
+	TotalParkingLot       int    `json:"total_parking_lot"`
+	OccupiedParkingLot    int    `json:"occupied_parking_lot"`
+	AvailableParkingLot   int    `json:"available_parking_lot"`
+	UnavailableParkingLot int    `json:"unavailable_parking_lot"`
+	TotalParkingIn        int    `json:"total_parking_in"`
+	TotalParkingOut       int    `json:"total_parking_out"`
+	Revenue               int    `json:"revenue"`
+	Currency              string `json:"currency"`
+}
+
+type SiteReports struct {
+	Sites []SiteReport `json:"sites"`
+	Total SiteReport   `json:"total"`
+}
+
+func SiteReportFrom(r response.SiteReportResponse) SiteReport {
+	return SiteReport{
+		SiteId:                r.SiteId,
+		Code:                  r.Code,
+		Name:                  r.Name,
+		TotalParkingLot:       r.TotalParkingLot,
+		OccupiedParkingLot:    r.OccupiedParkingLot,
+		AvailableParkingLot:   r.AvailableParkingLot,
+		UnavailableParkingLot: r.UnavailableParkingLot,
+		TotalParkingIn:        r.TotalParkingIn,
+		TotalParkingOut:       r.TotalParkingOut,
+		Revenue:               r.Revenue,
+		Currency:              constant.Currency,
+	}
+}
+
+func SiteReportsFrom(reports response.GetSiteReportsResponse) SiteReports {
+	result := SiteReports{Sites: make([]SiteReport, 0, len(reports.Data)), Total: SiteReportFrom(reports.Total)}
+	for _, r := range reports.Data {
+		result.Sites = append(result.Sites, SiteReportFrom(r))
+	}
+	return result
+}
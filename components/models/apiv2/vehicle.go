@@ -0,0 +1,85 @@
+package apiv2
+
+import (
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+)
+
+type Vehicle struct {
+	Id                  int        `json:"id,omitempty"`
+	SiteId              int        `json:"site_id,omitempty"`
+	Name                string     `json:"name"`
+	Type                string     `json:"type"`
+	FirstHourPrice      int        `json:"first_hour_price"`
+	PricePerHourPercent int        `json:"price_per_hour_percent"`
+	Currency            string     `json:"currency"`
+	Version             int        `json:"version,omitempty"`
+	CreatedAt           *time.Time `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+}
+
+func VehicleFrom(vd response.GetDetailVehicleResponse) Vehicle {
+	return Vehicle{
+		Id:                  vd.Id,
+		SiteId:              vd.SiteId,
+		Name:                vd.Name,
+		Type:                vd.Type,
+		FirstHourPrice:      vd.FirstHourPrice,
+		PricePerHourPercent: vd.PricePerHourPercent,
+		Currency:            constant.Currency,
+		Version:             vd.Version,
+		CreatedAt:           &vd.CreatedAt,
+		UpdatedAt:           &vd.UpdatedAt,
+	}
+}
+
+func VehiclesFrom(vehicles []response.GetDetailVehicleResponse) []Vehicle {
+	result := make([]Vehicle, 0, len(vehicles))
+	for _, vd := range vehicles {
+		result = append(result, VehicleFrom(vd))
+	}
+	return result
+}
+
+// VehicleFromCreate vehicle as submitted, usecase not return stored one.
+func VehicleFromCreate(req request.CreateVehicleRequest) Vehicle {
+	return Vehicle{
+		Name:                req.Name,
+		Type:                req.Type,
+		FirstHourPrice:      req.FirstHourPrice,
+		PricePerHourPercent: req.PricePerHourPercent,
+		Currency:            constant.Currency,
+	}
+}
+
+// VehicleFromUpdate vehicle as submitted along with its new version.
+func VehicleFromUpdate(req request.UpdateVehicleRequest) Vehicle {
+	v := Vehicle{
+		Id:                  req.Id,
+		Name:                req.Name,
+		Type:                req.Type,
+		FirstHourPrice:      req.FirstHourPrice,
+		PricePerHourPercent: req.PricePerHourPercent,
+		Currency:            constant.Currency,
+	}
+	if req.Version != nil {
+		v.Version = *req.Version
+	}
+	return v
+}
+
+// VehicleData v2 form of vehicle carried on v1 message response data, other data returned as is.
+func VehicleData(data interface{}) interface{} {
+	switch v := data.(type) {
+	case response.GetDetailVehicleResponse:
+		return VehicleFrom(v)
+	case request.CreateVehicleRequest:
+		return VehicleFromCreate(v)
+	case request.UpdateVehicleRequest:
+		return VehicleFromUpdate(v)
+	}
+	return data
+}
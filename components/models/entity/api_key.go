@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+const ApiKeyTableName = "api_key"
+
+// ApiKey credential of gate hardware sent on `x-api-key` header, only its hash is stored.
+// Site ID 0 means key not bound to any site.
+type ApiKey struct {
+	BaseEntity
+	SiteId     int        `json:"site_id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	KeyHash    string     `json:"key_hash"`
+	Scopes     []string   `json:"scopes"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	// ExpiredAt set when key rotated with grace period, key still valid until then.
+	ExpiredAt *time.Time `json:"expired_at"`
+	RevokedAt *time.Time `json:"revoked_at"`
+}
+
+// IsValid check whether key can still be used at `now`.
+func (k ApiKey) IsValid(now time.Time) bool {
+	return k.DeletedAt == nil && k.RevokedAt == nil && (k.ExpiredAt == nil || now.Before(*k.ExpiredAt))
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+const RefreshTokenTableName = "refresh_token"
+
+const RevokedTokenTableName = "revoked_token"
+
+// RefreshToken opaque refresh token issued with access token, only its hash is stored.
+// Refresh token used once, refreshing revoke it and issue a new one on same session.
+type RefreshToken struct {
+	BaseEntity
+	TokenHash   string     `json:"token_hash"`
+	SessionId   string     `json:"session_id"`
+	SubjectType string     `json:"subject_type"`
+	SubjectId   int        `json:"subject_id"`
+	ExpiredAt   time.Time  `json:"expired_at"`
+	RevokedAt   *time.Time `json:"revoked_at"`
+}
+
+// RevokedToken denylist entry of access token keyed by `jti`, kept until token expired.
+type RevokedToken struct {
+	BaseEntity
+	JTI       string    `json:"jti"`
+	SessionId string    `json:"session_id"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
@@ -9,4 +9,23 @@ type BaseEntity struct {
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
 	DeletedAt *time.Time `json:"deleted_at"`
+	// Version incremented on every write, used for optimistic concurrency.
+	Version int `json:"version"`
+}
+
+// Touch mark entity updated at `now`.
+func (b *BaseEntity) Touch(now time.Time) {
+	b.UpdatedAt = now
+	b.Version++
+}
+
+// MarkDeleted soft delete entity at `now`.
+func (b *BaseEntity) MarkDeleted(now time.Time) {
+	b.DeletedAt = &now
+	b.Version++
+}
+
+// MatchVersion check whether `version` expected by client still current, nil version always match.
+func (b BaseEntity) MatchVersion(version *int) bool {
+	return version == nil || *version == b.Version
 }
@@ -0,0 +1,13 @@
+package models
+
+const FloorTableName = "floor"
+
+type Floor struct {
+	BaseEntity
+	SiteId       int    `json:"site_id"`
+	Name         string `json:"name"`
+	Level        int    `json:"level"`
+	Capacity     int    `json:"capacity"`
+	IsClosed     bool   `json:"is_closed"`
+	ClosedReason string `json:"closed_reason"`
+}
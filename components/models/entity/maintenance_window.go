@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+const MaintenanceWindowTableName = "maintenance_window"
+
+// MaintenanceWindow block parking lots from allocation between `StartAt` and `EndAt`.
+// Window cover listed parking lots, or every lot of `FloorId` when set.
+type MaintenanceWindow struct {
+	BaseEntity
+	SiteId        int       `json:"site_id"`
+	ParkingLotIds []int     `json:"parking_lot_ids"`
+	FloorId       int       `json:"floor_id"`
+	StartAt       time.Time `json:"start_at"`
+	EndAt         time.Time `json:"end_at"`
+	Reason        string    `json:"reason"`
+}
+
+func (mw MaintenanceWindow) IsActive(now time.Time) bool {
+	return mw.DeletedAt == nil && !now.Before(mw.StartAt) && now.Before(mw.EndAt)
+}
+
+func (mw MaintenanceWindow) Covers(pl ParkingLot) bool {
+	if mw.SiteId != pl.SiteId {
+		return false
+	}
+	if mw.FloorId != 0 && mw.FloorId == pl.FloorId {
+		return true
+	}
+	for _, id := range mw.ParkingLotIds {
+		if id == pl.Id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+package models
+
+const OperatorTableName = "operator"
+
+const GateDeviceTableName = "gate_device"
+
+// Operator account allowed to request token with username and password.
+// Site ID 0 means operator not bound to any site.
+type Operator struct {
+	BaseEntity
+	SiteId int `json:"site_id"`
+	// AllSites operator allowed on every site, eg: head office admin, otherwise only on SiteId.
+	AllSites     bool     `json:"all_sites"`
+	Username     string   `json:"username"`
+	PasswordHash string   `json:"password_hash"`
+	Roles        []string `json:"roles"`
+	IsActive     bool     `json:"is_active"`
+}
+
+// GateDevice credential of gate device requesting token with client ID and secret, always bound to a site.
+type GateDevice struct {
+	BaseEntity
+	SiteId     int    `json:"site_id"`
+	ClientId   string `json:"client_id"`
+	Name       string `json:"name"`
+	SecretHash string `json:"secret_hash"`
+	IsActive   bool   `json:"is_active"`
+}
@@ -1,10 +1,38 @@
 package models
 
+import (
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+)
+
 const ParkingLotTableName = "parking_lot"
 
 type ParkingLot struct {
 	BaseEntity
-	Name     string `json:"name"`
-	Floor    string `json:"floor"`
-	IsParked bool   `json:"isParked"`
+	SiteId       int    `json:"site_id"`
+	Name         string `json:"name"`
+	Floor        string `json:"floor"`
+	FloorId      int    `json:"floor_id"`
+	ZoneId       int    `json:"zone_id"`
+	IsParked     bool   `json:"isParked"`
+	Status       string `json:"status"`
+	StatusReason string `json:"status_reason"`
+}
+
+// CurrentStatus return status of parking lot at `now`. Parked vehicle always make the lot occupied,
+// then status set by admin, then maintenance window covering the lot.
+func (pl ParkingLot) CurrentStatus(windows []MaintenanceWindow, now time.Time) string {
+	if pl.IsParked {
+		return constant.LotOccupied
+	}
+	if pl.Status != "" && pl.Status != constant.LotAvailable {
+		return pl.Status
+	}
+	for _, mw := range windows {
+		if mw.IsActive(now) && mw.Covers(pl) {
+			return constant.LotMaintenance
+		}
+	}
+	return constant.LotAvailable
 }
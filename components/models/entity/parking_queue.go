@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+const ParkingQueueTableName = "parking_queue"
+
+type ParkingQueue struct {
+	BaseEntity
+	SiteId            int        `json:"site_id"`
+	PlateNumber       string     `json:"plate_number"`
+	Type              string     `json:"type"`
+	Color             string     `json:"color"`
+	Status            int        `json:"status"`
+	OfferedParkingLot string     `json:"offered_parking_lot"`
+	OfferExpiredAt    *time.Time `json:"offer_expired_at"`
+}
@@ -6,6 +6,7 @@ const ParkingVehicleStatusTableName = "parking_vehicle_status"
 
 type ParkingVehicleStatus struct {
 	BaseEntity
+	SiteId         int        `json:"site_id"`
 	Name           string     `json:"name"`
 	PlateNumber    string     `json:"plate_number"`
 	Type           string     `json:"type"`
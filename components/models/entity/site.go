@@ -0,0 +1,10 @@
+package models
+
+const SiteTableName = "site"
+
+type Site struct {
+	BaseEntity
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
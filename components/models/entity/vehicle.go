@@ -4,6 +4,7 @@ const VehicleTableName = "vehicle"
 
 type Vehicle struct {
 	BaseEntity
+	SiteId              int    `json:"site_id"`
 	Name                string `json:"name"`
 	Type                string `json:"type"`
 	FirstHourPrice      int    `json:"first_hour_price"`
@@ -0,0 +1,10 @@
+package models
+
+const ZoneTableName = "zone"
+
+type Zone struct {
+	BaseEntity
+	SiteId  int    `json:"site_id"`
+	FloorId int    `json:"floor_id"`
+	Name    string `json:"name"`
+}
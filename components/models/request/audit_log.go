@@ -0,0 +1,14 @@
+package request
+
+import "time"
+
+type GetAuditLogRequest struct {
+	BaseGetListParams
+	Actor     string    `json:"actor"`
+	RequestId string    `json:"request_id"`
+	Action    string    `json:"action"`
+	Entity    string    `json:"entity"`
+	EntityId  string    `json:"entity_id"`
+	From      time.Time `json:"from"`
+	To        time.Time `json:"to"`
+}
@@ -0,0 +1,78 @@
+package request
+
+type TokenRequest struct {
+	GrantType    string `json:"grant_type" validate:"required,oneof=password client_credentials"`
+	Username     string `json:"username" validate:"required_if=GrantType password"`
+	Password     string `json:"password" validate:"required_if=GrantType password"`
+	ClientId     string `json:"client_id" validate:"required_if=GrantType client_credentials"`
+	ClientSecret string `json:"client_secret" validate:"required_if=GrantType client_credentials"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type RevokeTokenRequest struct {
+	// JTI of token to revoke, empty revoke token used on this request.
+	JTI string `json:"jti"`
+}
+
+type CreateOperatorRequest struct {
+	SiteId   int      `json:"site_id" validate:"gte=0"`
+	AllSites bool     `json:"all_sites"`
+	Username string   `json:"username" validate:"required,min=3"`
+	Password string   `json:"password" validate:"required,min=8"`
+	Roles    []string `json:"roles" validate:"required,min=1,dive,oneof=admin operator auditor"`
+}
+
+type UpdateOperatorRequest struct {
+	Id       string   `json:"operator_id" validate:"required,numeric"`
+	SiteId   int      `json:"site_id" validate:"gte=0"`
+	AllSites bool     `json:"all_sites"`
+	Password string   `json:"password" validate:"omitempty,min=8"`
+	Roles    []string `json:"roles" validate:"required,min=1,dive,oneof=admin operator auditor"`
+	IsActive *bool    `json:"is_active" validate:"required"`
+	Version  *int     `json:"version"`
+}
+
+type DeleteOperatorRequest struct {
+	OperatorId string `json:"operator_id" validate:"required,numeric"`
+}
+
+type GetOperatorRequest struct {
+	BaseGetListParams
+}
+
+type CreateGateDeviceRequest struct {
+	SiteId   int    `json:"site_id" validate:"gte=0"`
+	ClientId string `json:"client_id" validate:"required,min=3"`
+	Name     string `json:"name" validate:"required"`
+}
+
+type DeleteGateDeviceRequest struct {
+	GateDeviceId string `json:"gate_device_id" validate:"required,numeric"`
+}
+
+type GetGateDeviceRequest struct {
+	BaseGetListParams
+}
+
+type CreateApiKeyRequest struct {
+	SiteId int      `json:"site_id" validate:"gte=0"`
+	Name   string   `json:"name" validate:"required"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,oneof=parking-in parking-out read-only"`
+}
+
+type RotateApiKeyRequest struct {
+	ApiKeyId string `json:"api_key_id" validate:"required,numeric"`
+	// GracePeriod seconds old key still valid after rotation, so device can be reconfigured.
+	GracePeriod int `json:"grace_period" validate:"gte=0,lte=604800"`
+}
+
+type RevokeApiKeyRequest struct {
+	ApiKeyId string `json:"api_key_id" validate:"required,numeric"`
+}
+
+type GetApiKeyRequest struct {
+	BaseGetListParams
+}
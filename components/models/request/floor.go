@@ -0,0 +1,59 @@
+package request
+
+type CreateFloorRequest struct {
+	Name     string `json:"name" validate:"required"`
+	Level    int    `json:"level"`
+	Capacity int    `json:"capacity" validate:"gte=0"`
+}
+
+type UpdateFloorRequest struct {
+	Id       string `json:"floor_id" validate:"required,numeric"`
+	Name     string `json:"name" validate:"required"`
+	Level    int    `json:"level"`
+	Capacity int    `json:"capacity" validate:"gte=0"`
+	Version  *int   `json:"version"`
+}
+
+type SetFloorStatusRequest struct {
+	FloorId  string `json:"floor_id" validate:"required,numeric"`
+	IsClosed *bool  `json:"is_closed" validate:"required"`
+	Reason   string `json:"reason"`
+	Version  *int   `json:"version"`
+}
+
+type DeleteFloorRequest struct {
+	FloorId string `json:"floor_id" validate:"required,numeric"`
+}
+
+type GetDetailFloorRequest struct {
+	FloorId string `json:"floor_id" validate:"required,numeric"`
+}
+
+type GetFloorRequest struct {
+	BaseGetListParams
+}
+
+type CreateZoneRequest struct {
+	FloorId int    `json:"floor_id" validate:"required"`
+	Name    string `json:"name" validate:"required"`
+}
+
+type UpdateZoneRequest struct {
+	Id      string `json:"zone_id" validate:"required,numeric"`
+	FloorId int    `json:"floor_id" validate:"required"`
+	Name    string `json:"name" validate:"required"`
+	Version *int   `json:"version"`
+}
+
+type DeleteZoneRequest struct {
+	ZoneId string `json:"zone_id" validate:"required,numeric"`
+}
+
+type GetDetailZoneRequest struct {
+	ZoneId string `json:"zone_id" validate:"required,numeric"`
+}
+
+type GetZoneRequest struct {
+	BaseGetListParams
+	FloorId int `json:"floor_id" validate:"gte=0"`
+}
@@ -18,3 +18,13 @@ type GetParkingData struct {
 type GetCountParkingData struct {
 	Tipe string `json:"tipe" validate:"required"`
 }
+
+type GetParkingSessionRequest struct {
+	PlatNomor string `json:"plat_nomor"`
+	// All include sessions already parked out.
+	All bool `json:"all"`
+}
+
+type CancelParkingQueueRequest struct {
+	PlatNomor string `json:"plat_nomor" validate:"required"`
+}
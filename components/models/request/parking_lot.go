@@ -1,14 +1,21 @@
 package request
 
+import "time"
+
 type CreateParkingLotRequest struct {
-	Name  string `json:"name" validate:"required"`
-	Floor string `json:"floor" validate:"required"`
+	Name    string `json:"name" validate:"required"`
+	Floor   string `json:"floor" validate:"required_without=FloorId"`
+	FloorId int    `json:"floor_id" validate:"gte=0"`
+	ZoneId  int    `json:"zone_id" validate:"gte=0"`
 }
 
 type UpdateParkingLotRequest struct {
-	Id    string `json:"parking_lot_id" validate:"required,numeric"`
-	Name  string `json:"name" validate:"required"`
-	Floor string `json:"floor" validate:"required"`
+	Id      string `json:"parking_lot_id" validate:"required,numeric"`
+	Name    string `json:"name" validate:"required"`
+	Floor   string `json:"floor" validate:"required_without=FloorId"`
+	FloorId int    `json:"floor_id" validate:"gte=0"`
+	ZoneId  int    `json:"zone_id" validate:"gte=0"`
+	Version *int   `json:"version"`
 }
 
 type DeleteParkingLotRequest struct {
@@ -22,3 +29,50 @@ type GetDetailParkingLotRequest struct {
 type GetParkingLotRequest struct {
 	BaseGetListParams
 }
+
+type CreateBulkParkingLotRequest struct {
+	Floor   string                    `json:"floor"`
+	FloorId int                       `json:"floor_id" validate:"gte=0"`
+	ZoneId  int                       `json:"zone_id" validate:"gte=0"`
+	Prefix  string                    `json:"prefix"`
+	Start   int                       `json:"start" validate:"gte=0"`
+	End     int                       `json:"end" validate:"gte=0"`
+	Pad     int                       `json:"pad" validate:"gte=0,lte=10"`
+	Items   []CreateParkingLotRequest `json:"items"`
+}
+
+type UpdateBulkParkingLotRequest struct {
+	Items []UpdateParkingLotRequest `json:"items" validate:"required,min=1"`
+}
+
+type DeleteBulkParkingLotRequest struct {
+	ParkingLotIds []int `json:"parking_lot_ids" validate:"required,min=1,dive,gt=0"`
+}
+
+type SetParkingLotStatusRequest struct {
+	ParkingLotId string `json:"parking_lot_id" validate:"required,numeric"`
+	Status       string `json:"status" validate:"required,oneof=AVAILABLE MAINTENANCE RESERVED DISABLED"`
+	Reason       string `json:"reason"`
+	Version      *int   `json:"version"`
+}
+
+type CreateMaintenanceWindowRequest struct {
+	ParkingLotIds []int     `json:"parking_lot_ids" validate:"required_without=FloorId,dive,gt=0"`
+	FloorId       int       `json:"floor_id" validate:"gte=0"`
+	StartAt       time.Time `json:"start_at" validate:"required"`
+	EndAt         time.Time `json:"end_at" validate:"required,gtfield=StartAt"`
+	Reason        string    `json:"reason"`
+}
+
+type DeleteMaintenanceWindowRequest struct {
+	MaintenanceWindowId string `json:"maintenance_window_id" validate:"required,numeric"`
+}
+
+type GetDetailMaintenanceWindowRequest struct {
+	MaintenanceWindowId string `json:"maintenance_window_id" validate:"required,numeric"`
+}
+
+type GetMaintenanceWindowRequest struct {
+	BaseGetListParams
+	ActiveOnly bool `json:"active_only"`
+}
@@ -0,0 +1,27 @@
+package request
+
+type CreateSiteRequest struct {
+	Code    string `json:"code" validate:"required"`
+	Name    string `json:"name" validate:"required"`
+	Address string `json:"address"`
+}
+
+type UpdateSiteRequest struct {
+	Id      string `json:"site_id" validate:"required,numeric"`
+	Code    string `json:"code" validate:"required"`
+	Name    string `json:"name" validate:"required"`
+	Address string `json:"address"`
+	Version *int   `json:"version"`
+}
+
+type DeleteSiteRequest struct {
+	SiteId string `json:"site_id" validate:"required,numeric"`
+}
+
+type GetDetailSiteRequest struct {
+	SiteId string `json:"site_id" validate:"required,numeric"`
+}
+
+type GetSiteRequest struct {
+	BaseGetListParams
+}
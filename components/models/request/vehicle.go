@@ -13,6 +13,7 @@ type UpdateVehicleRequest struct {
 	Type                string `json:"type" validate:"required"`
 	FirstHourPrice      int    `json:"first_hour_price" validate:"required"`
 	PricePerHourPercent int    `json:"price_per_hour_percent" validate:"required"`
+	Version             *int   `json:"version"`
 }
 
 type DeleteVehicleRequest struct {
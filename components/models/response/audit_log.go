@@ -0,0 +1,31 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditLogResponse struct {
+	Seq       int             `json:"seq"`
+	SiteId    int             `json:"site_id"`
+	Actor     string          `json:"actor"`
+	RequestId string          `json:"request_id"`
+	Action    string          `json:"action"`
+	Entity    string          `json:"entity"`
+	EntityId  string          `json:"entity_id"`
+	Before    json.RawMessage `json:"before,omitempty"`
+	After     json.RawMessage `json:"after,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+	PrevHash  string          `json:"prev_hash"`
+	Hash      string          `json:"hash"`
+}
+
+type GetAuditLogsResponse struct {
+	Total int                `json:"total"`
+	Data  []AuditLogResponse `json:"data"`
+}
+
+type VerifyAuditLogResponse struct {
+	Intact    bool `json:"intact"`
+	BrokenSeq int  `json:"broken_seq,omitempty"`
+}
@@ -0,0 +1,65 @@
+package response
+
+import "time"
+
+type TokenResponse struct {
+	AccessToken  string   `json:"access_token"`
+	TokenType    string   `json:"token_type"`
+	ExpiresIn    int      `json:"expires_in"`
+	RefreshToken string   `json:"refresh_token"`
+	Roles        []string `json:"roles"`
+	SiteId       int      `json:"site_id"`
+	AllSites     bool     `json:"all_sites"`
+}
+
+type GetDetailOperatorResponse struct {
+	BaseResponse
+	SiteId   int      `json:"site_id"`
+	AllSites bool     `json:"all_sites"`
+	Username string   `json:"username"`
+	Roles    []string `json:"roles"`
+	IsActive bool     `json:"is_active"`
+}
+
+type GetOperatorsResponse struct {
+	Data []GetDetailOperatorResponse `json:"data"`
+}
+
+type GetDetailGateDeviceResponse struct {
+	BaseResponse
+	SiteId   int    `json:"site_id"`
+	ClientId string `json:"client_id"`
+	Name     string `json:"name"`
+	IsActive bool   `json:"is_active"`
+}
+
+type GetGateDevicesResponse struct {
+	Data []GetDetailGateDeviceResponse `json:"data"`
+}
+
+// CreateGateDeviceResponse carry generated client secret, shown only once.
+type CreateGateDeviceResponse struct {
+	GetDetailGateDeviceResponse
+	ClientSecret string `json:"client_secret"`
+}
+
+type GetDetailApiKeyResponse struct {
+	BaseResponse
+	SiteId     int        `json:"site_id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	ExpiredAt  *time.Time `json:"expired_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+type GetApiKeysResponse struct {
+	Data []GetDetailApiKeyResponse `json:"data"`
+}
+
+// CreateApiKeyResponse carry generated API key, shown only once.
+type CreateApiKeyResponse struct {
+	GetDetailApiKeyResponse
+	ApiKey string `json:"api_key"`
+}
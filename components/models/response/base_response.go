@@ -6,4 +6,5 @@ type BaseResponse struct {
 	Id        int       `json:"id"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	Version   int       `json:"version"`
 }
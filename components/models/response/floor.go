@@ -0,0 +1,44 @@
+package response
+
+type GetDetailFloorResponse struct {
+	BaseResponse
+	SiteId       int    `json:"site_id"`
+	Name         string `json:"name"`
+	Level        int    `json:"level"`
+	Capacity     int    `json:"capacity"`
+	IsClosed     bool   `json:"is_closed"`
+	ClosedReason string `json:"closed_reason"`
+}
+
+type GetFloorsResponse struct {
+	Data []GetDetailFloorResponse `json:"data"`
+}
+
+type GetDetailZoneResponse struct {
+	BaseResponse
+	SiteId  int    `json:"site_id"`
+	FloorId int    `json:"floor_id"`
+	Name    string `json:"name"`
+}
+
+type GetZonesResponse struct {
+	Data []GetDetailZoneResponse `json:"data"`
+}
+
+type FloorOccupancyResponse struct {
+	FloorId               int    `json:"floor_id"`
+	Name                  string `json:"name"`
+	Level                 int    `json:"level"`
+	Capacity              int    `json:"capacity"`
+	IsClosed              bool   `json:"is_closed"`
+	TotalParkingLot       int    `json:"total_parking_lot"`
+	OccupiedParkingLot    int    `json:"occupied_parking_lot"`
+	AvailableParkingLot   int    `json:"available_parking_lot"`
+	MaintenanceParkingLot int    `json:"maintenance_parking_lot"`
+	ReservedParkingLot    int    `json:"reserved_parking_lot"`
+	DisabledParkingLot    int    `json:"disabled_parking_lot"`
+}
+
+type GetFloorOccupancyResponse struct {
+	Data []FloorOccupancyResponse `json:"data"`
+}
@@ -0,0 +1,25 @@
+package response
+
+type HealthResponse struct {
+	Status string `json:"status"`
+}
+
+type ReadinessCheckResponse struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type ReadinessResponse struct {
+	Status string                   `json:"status"`
+	Checks []ReadinessCheckResponse `json:"checks"`
+}
+
+type VersionResponse struct {
+	Version   string `json:"version"`
+	BuildTime string `json:"build_time"`
+	BuildUser string `json:"build_user"`
+	Branch    string `json:"branch"`
+	CommitSHA string `json:"commit_sha"`
+	GoVersion string `json:"go_version"`
+}
@@ -22,3 +22,35 @@ type GetDataParkingResponse struct {
 type GetCountParkingResponse struct {
 	JumlahKendaraan int `json:"jumlah_kendaraan"`
 }
+
+type ParkingQueueResponse struct {
+	Id                int        `json:"id"`
+	PlatNomor         string     `json:"plat_nomor"`
+	Warna             string     `json:"warna"`
+	Tipe              string     `json:"tipe"`
+	Status            string     `json:"status"`
+	QueuePosition     int        `json:"queue_position"`
+	OfferedParkingLot string     `json:"offered_parking_lot,omitempty"`
+	OfferExpiredAt    *time.Time `json:"offer_expired_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+type GetParkingQueueResponse struct {
+	Data []ParkingQueueResponse `json:"data"`
+}
+
+type ParkingSessionResponse struct {
+	Id            int        `json:"id"`
+	PlatNomor     string     `json:"plat_nomor"`
+	Warna         string     `json:"warna"`
+	Tipe          string     `json:"tipe"`
+	ParkingLot    string     `json:"parking_lot"`
+	Status        string     `json:"status"`
+	JumlahBayar   int        `json:"jumlah_bayar"`
+	TanggalMasuk  time.Time  `json:"tanggal_masuk"`
+	TanggalKeluar *time.Time `json:"tanggal_keluar"`
+}
+
+type GetParkingSessionsResponse struct {
+	Data []ParkingSessionResponse `json:"data"`
+}
@@ -1,12 +1,52 @@
 package response
 
+import "time"
+
 type GetDetailParkingLotResponse struct {
 	BaseResponse
-	Name     string `json:"name"`
-	Floor    string `json:"floor"`
-	IsParked bool   `json:"isParked"`
+	SiteId       int    `json:"site_id"`
+	Name         string `json:"name"`
+	Floor        string `json:"floor"`
+	FloorId      int    `json:"floor_id"`
+	ZoneId       int    `json:"zone_id"`
+	IsParked     bool   `json:"isParked"`
+	Status       string `json:"status"`
+	StatusReason string `json:"status_reason"`
 }
 
 type GetParkingLotsResponse struct {
 	Data []GetDetailParkingLotResponse `json:"data"`
 }
+
+type BulkParkingLotItemResponse struct {
+	Index        int    `json:"index"`
+	ParkingLotId int    `json:"parking_lot_id"`
+	Name         string `json:"name"`
+	Floor        string `json:"floor"`
+	FloorId      int    `json:"floor_id"`
+	ZoneId       int    `json:"zone_id"`
+	Status       string `json:"status"`
+	Message      string `json:"message"`
+}
+
+type BulkParkingLotResponse struct {
+	Total   int                          `json:"total"`
+	Success int                          `json:"success"`
+	Failed  int                          `json:"failed"`
+	Items   []BulkParkingLotItemResponse `json:"items"`
+}
+
+type GetDetailMaintenanceWindowResponse struct {
+	BaseResponse
+	SiteId        int       `json:"site_id"`
+	ParkingLotIds []int     `json:"parking_lot_ids"`
+	FloorId       int       `json:"floor_id"`
+	StartAt       time.Time `json:"start_at"`
+	EndAt         time.Time `json:"end_at"`
+	Reason        string    `json:"reason"`
+	IsActive      bool      `json:"is_active"`
+}
+
+type GetMaintenanceWindowsResponse struct {
+	Data []GetDetailMaintenanceWindowResponse `json:"data"`
+}
@@ -0,0 +1,30 @@
+package response
+
+type GetDetailSiteResponse struct {
+	BaseResponse
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+type GetSitesResponse struct {
+	Data []GetDetailSiteResponse `json:"data"`
+}
+
+type SiteReportResponse struct {
+	SiteId                int    `json:"site_id"`
+	Code                  string `json:"code"`
+	Name                  string `json:"name"`
+	TotalParkingLot       int    `json:"total_parking_lot"`
+	OccupiedParkingLot    int    `json:"occupied_parking_lot"`
+	AvailableParkingLot   int    `json:"available_parking_lot"`
+	UnavailableParkingLot int    `json:"unavailable_parking_lot"`
+	TotalParkingIn        int    `json:"total_parking_in"`
+	TotalParkingOut       int    `json:"total_parking_out"`
+	Revenue               int    `json:"revenue"`
+}
+
+type GetSiteReportsResponse struct {
+	Data  []SiteReportResponse `json:"data"`
+	Total SiteReportResponse   `json:"total"`
+}
@@ -2,6 +2,7 @@ package response
 
 type GetDetailVehicleResponse struct {
 	BaseResponse
+	SiteId              int    `json:"site_id"`
 	Name                string `json:"name"`
 	Type                string `json:"type"`
 	FirstHourPrice      int    `json:"first_hour_price"`
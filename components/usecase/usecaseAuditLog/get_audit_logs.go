@@ -0,0 +1,60 @@
+package usecaseAuditLog
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+)
+
+// GetAuditLogs return audit trail of caller site newest first.
+func (ctx *usecaseObj) GetAuditLogs(dc contexts.BearerContext, req *request.GetAuditLogRequest) (*response.GetAuditLogsResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetAuditLogs")
+	defer end()
+
+	siteId := dc.GetSiteID()
+	entries, total, err := ctx.Audit.Query(audit.Filter{
+		SiteId:    &siteId,
+		Actor:     req.Actor,
+		RequestId: req.RequestId,
+		Action:    req.Action,
+		Entity:    req.Entity,
+		EntityId:  req.EntityId,
+		From:      req.From,
+		To:        req.To,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+	})
+	if err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+
+	resp := response.GetAuditLogsResponse{
+		Total: total,
+		Data:  []response.AuditLogResponse{},
+	}
+	for _, e := range entries {
+		resp.Data = append(resp.Data, toAuditLogResponse(e))
+	}
+	return &resp, nil
+}
+
+func toAuditLogResponse(e audit.Entry) response.AuditLogResponse {
+	return response.AuditLogResponse{
+		Seq:       e.Seq,
+		SiteId:    e.SiteId,
+		Actor:     e.Actor,
+		RequestId: e.RequestId,
+		Action:    e.Action,
+		Entity:    e.Entity,
+		EntityId:  e.EntityId,
+		Before:    e.Before,
+		After:     e.After,
+		CreatedAt: e.CreatedAt,
+		PrevHash:  e.PrevHash,
+		Hash:      e.Hash,
+	}
+}
@@ -0,0 +1,36 @@
+package usecaseAuditLog
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+)
+
+type IUsecaseAuditLog interface {
+	GetAuditLogs(dc contexts.BearerContext, req *request.GetAuditLogRequest) (*response.GetAuditLogsResponse, *errs.Errs)
+	VerifyAuditLogs(dc contexts.BearerContext) (*response.VerifyAuditLogResponse, *errs.Errs)
+}
+
+type usecaseObj struct {
+	Audit audit.Trail
+}
+
+func NewAuditLogUsecase(ctx ...interface{}) IUsecaseAuditLog {
+	handle := usecaseObj{}
+	for _, c := range ctx {
+		switch c.(type) {
+		case audit.Trail:
+			handle.Audit = c.(audit.Trail)
+		}
+	}
+	return &handle
+}
+
+// traced usecase under span `name`, span ended by returned func.
+func (ctx *usecaseObj) traced(dc contexts.BearerContext, name string) (*usecaseObj, func()) {
+	_, end := dc.StartSpan("UsecaseAuditLog." + name)
+	return ctx, end
+}
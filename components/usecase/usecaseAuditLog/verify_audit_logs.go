@@ -0,0 +1,24 @@
+package usecaseAuditLog
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+)
+
+// VerifyAuditLogs check hash chain of whole audit trail, first edited or removed entry reported.
+func (ctx *usecaseObj) VerifyAuditLogs(dc contexts.BearerContext) (*response.VerifyAuditLogResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "VerifyAuditLogs")
+	defer end()
+
+	broken, err := ctx.Audit.Verify()
+	if err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	return &response.VerifyAuditLogResponse{
+		Intact:    broken == 0,
+		BrokenSeq: broken,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package usecaseAuth
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ApiKeySuite struct {
+	suite.Suite
+	usecase IUsecaseAuth
+	fs      *loadHookFileSystem
+	dc      contexts.BearerContext
+}
+
+func (s *ApiKeySuite) SetupTest() {
+	s.fs = &loadHookFileSystem{IFileSystem: file.NewFileSystem(s.T().TempDir() + "/")}
+	s.usecase = NewAuthUsecase(s.fs)
+}
+
+func (s *ApiKeySuite) createApiKey() *response.CreateApiKeyResponse {
+	resp, err := s.usecase.CreateApiKey(s.dc, request.CreateApiKeyRequest{Name: "gate", Scopes: []string{constant.ScopeParkingIn}})
+	s.Require().Nil(err)
+	return resp.Data.(*response.CreateApiKeyResponse)
+}
+
+func (s *ApiKeySuite) TestRotateInvalidId() {
+	_, err := s.usecase.RotateApiKey(s.dc, request.RotateApiKeyRequest{ApiKeyId: "99999999999999999999"})
+	s.Require().NotNil(err)
+	s.Equal(strconv.Itoa(errs.BadRequest), err.Code)
+
+	_, err = s.usecase.RevokeApiKey(s.dc, &request.RevokeApiKeyRequest{ApiKeyId: "7"})
+	s.Require().NotNil(err)
+	s.Equal(strconv.Itoa(errs.NotFound), err.Code)
+}
+
+func (s *ApiKeySuite) TestResolveNeverRestoreRevokedKey() {
+	created := s.createApiKey()
+	// key revoked right after resolver read the table, before it record last used timestamp
+	s.fs.afterLoad = func() {
+		_, err := s.usecase.RevokeApiKey(s.dc, &request.RevokeApiKeyRequest{ApiKeyId: strconv.Itoa(created.Id)})
+		s.Nil(err)
+	}
+
+	_, err := s.usecase.ResolveApiKey(created.ApiKey)
+	s.Error(err)
+	_, err = s.usecase.ResolveApiKey(created.ApiKey)
+	s.Error(err, "revoked key must stay revoked")
+}
+
+// loadHookFileSystem run `afterLoad` once, after the next API key table read.
+type loadHookFileSystem struct {
+	file.IFileSystem
+	afterLoad func()
+}
+
+func (fs *loadHookFileSystem) LoadFile(fileName string) ([]byte, error) {
+	data, err := fs.IFileSystem.LoadFile(fileName)
+	if hook := fs.afterLoad; hook != nil && fileName == models.ApiKeyTableName {
+		fs.afterLoad = nil
+		hook()
+	}
+	return data, err
+}
+
+func TestApiKeySuite(t *testing.T) {
+	suite.Run(t, new(ApiKeySuite))
+}
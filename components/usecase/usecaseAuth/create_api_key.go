@@ -0,0 +1,70 @@
+package usecaseAuth
+
+import (
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// CreateApiKey issue API key for gate hardware, generated key returned only on this response.
+func (ctx *usecaseObj) CreateApiKey(dc contexts.BearerContext, req request.CreateApiKeyRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "CreateApiKey")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ApiKeyTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	apiKeyData := []models.ApiKey{}
+	if err := file.LoadTable(ctx.FileSystem, models.ApiKeyTableName, &apiKeyData); err != nil {
+		return nil, err
+	}
+
+	apiKeyData, created, err := appendApiKey(apiKeyData, req.SiteId, req.Name, req.Scopes, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.saveTable(models.ApiKeyTableName, apiKeyData); err != nil {
+		return nil, err
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditCreate, models.ApiKeyTableName, created.Id, nil, created.GetDetailApiKeyResponse)
+	resp.Message = "Success"
+	resp.Data = created
+	return &resp, nil
+}
+
+// appendApiKey generate a new API key and append it into `apiKeyData`.
+func appendApiKey(apiKeyData []models.ApiKey, siteId int, name string, scopes []string, dateNow time.Time) ([]models.ApiKey, *response.CreateApiKeyResponse, *errs.Errs) {
+	secret, errRand := randomToken(32)
+	if errRand != nil {
+		return nil, nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errRand.Error())
+	}
+	key := constant.ApiKeyPrefix + secret
+
+	apiKey := models.ApiKey{
+		BaseEntity: models.BaseEntity{
+			Id:        len(apiKeyData) + 1,
+			CreatedAt: dateNow,
+			UpdatedAt: dateNow,
+			DeletedAt: nil,
+		},
+		SiteId:  siteId,
+		Name:    name,
+		Prefix:  key[:len(constant.ApiKeyPrefix)+6],
+		KeyHash: hashToken(key),
+		Scopes:  scopes,
+	}
+	return append(apiKeyData, apiKey), &response.CreateApiKeyResponse{
+		GetDetailApiKeyResponse: toApiKeyResponse(apiKey),
+		ApiKey:                  key,
+	}, nil
+}
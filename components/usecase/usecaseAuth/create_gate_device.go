@@ -0,0 +1,78 @@
+package usecaseAuth
+
+import (
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// CreateGateDevice register gate device credential, generated client secret returned only on this response.
+func (ctx *usecaseObj) CreateGateDevice(dc contexts.BearerContext, req request.CreateGateDeviceRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "CreateGateDevice")
+	defer end()
+	defer ctx.FileSystem.Lock(models.GateDeviceTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	gateDeviceData := []models.GateDevice{}
+	if err := file.LoadTable(ctx.FileSystem, models.GateDeviceTableName, &gateDeviceData); err != nil {
+		return nil, err
+	}
+
+	for _, gd := range gateDeviceData {
+		if gd.DeletedAt == nil && gd.ClientId == req.ClientId {
+			return nil, errs.NewErrContext().
+				SetCode(errs.Conflict).
+				SetMessage("Client ID Already Exists")
+		}
+	}
+
+	secret, errRand := randomToken(24)
+	if errRand != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errRand.Error())
+	}
+	hash, errHash := bcrypt.GenerateFromPassword([]byte(secret), bcrypt.DefaultCost)
+	if errHash != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errHash.Error())
+	}
+
+	dateNow := time.Now().UTC()
+	gateDevice := models.GateDevice{
+		BaseEntity: models.BaseEntity{
+			Id:        len(gateDeviceData) + 1,
+			CreatedAt: dateNow,
+			UpdatedAt: dateNow,
+			DeletedAt: nil,
+		},
+		SiteId:     req.SiteId,
+		ClientId:   req.ClientId,
+		Name:       req.Name,
+		SecretHash: string(hash),
+		IsActive:   true,
+	}
+	gateDeviceData = append(gateDeviceData, gateDevice)
+	if err := ctx.saveTable(models.GateDeviceTableName, gateDeviceData); err != nil {
+		return nil, err
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditCreate, models.GateDeviceTableName, gateDevice.Id, nil, toGateDeviceResponse(gateDevice))
+	resp.Message = "Success"
+	resp.Data = response.CreateGateDeviceResponse{
+		GetDetailGateDeviceResponse: toGateDeviceResponse(gateDevice),
+		ClientSecret:                secret,
+	}
+	return &resp, nil
+}
@@ -0,0 +1,94 @@
+package usecaseAuth
+
+import (
+	"strings"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (ctx *usecaseObj) CreateOperator(dc contexts.BearerContext, req request.CreateOperatorRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "CreateOperator")
+	defer end()
+	defer ctx.FileSystem.Lock(models.OperatorTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	operatorData := []models.Operator{}
+	if err := file.LoadTable(ctx.FileSystem, models.OperatorTableName, &operatorData); err != nil {
+		return nil, err
+	}
+
+	for _, op := range operatorData {
+		if op.DeletedAt == nil && strings.EqualFold(op.Username, req.Username) {
+			return nil, errs.NewErrContext().
+				SetCode(errs.Conflict).
+				SetMessage("Username Already Exists")
+		}
+	}
+
+	hash, errHash := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if errHash != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errHash.Error())
+	}
+
+	dateNow := time.Now().UTC()
+	operator := models.Operator{
+		BaseEntity: models.BaseEntity{
+			Id:        len(operatorData) + 1,
+			CreatedAt: dateNow,
+			UpdatedAt: dateNow,
+			DeletedAt: nil,
+		},
+		SiteId:       req.SiteId,
+		AllSites:     req.AllSites,
+		Username:     req.Username,
+		PasswordHash: string(hash),
+		Roles:        req.Roles,
+		IsActive:     true,
+	}
+	operatorData = append(operatorData, operator)
+	if err := ctx.saveTable(models.OperatorTableName, operatorData); err != nil {
+		return nil, err
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditCreate, models.OperatorTableName, operator.Id, nil, toOperatorResponse(operator))
+	resp.Message = "Success"
+	resp.Data = toOperatorResponse(operator)
+	return &resp, nil
+}
+
+// EnsureBootstrapAdmin create admin operator `username` allowed on every site when there is no operator yet,
+// so the first token can be requested on fresh installation.
+func (ctx *usecaseObj) EnsureBootstrapAdmin(username, password string) error {
+	operatorData := []models.Operator{}
+	if err := file.LoadTable(ctx.FileSystem, models.OperatorTableName, &operatorData); err != nil {
+		return err
+	}
+	for _, op := range operatorData {
+		if op.DeletedAt == nil {
+			return nil
+		}
+	}
+	_, err := ctx.CreateOperator(contexts.BearerContext{}, request.CreateOperatorRequest{
+		Username: username,
+		Password: password,
+		AllSites: true,
+		Roles:    []string{constant.RoleAdmin},
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
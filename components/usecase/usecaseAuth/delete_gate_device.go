@@ -0,0 +1,59 @@
+package usecaseAuth
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) DeleteGateDevice(dc contexts.BearerContext, req *request.DeleteGateDeviceRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "DeleteGateDevice")
+	defer end()
+	defer ctx.FileSystem.Lock(models.GateDeviceTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	gateDeviceData := []models.GateDevice{}
+	if err := file.LoadTable(ctx.FileSystem, models.GateDeviceTableName, &gateDeviceData); err != nil {
+		return nil, err
+	}
+
+	id, errConv := strconv.Atoi(req.GateDeviceId)
+	if errConv != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errConv.Error())
+	}
+	idx := -1
+	for i, gd := range gateDeviceData {
+		if gd.Id == id && gd.DeletedAt == nil {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Data Not Found")
+	}
+
+	before := toGateDeviceResponse(gateDeviceData[idx])
+	gateDeviceData[idx].MarkDeleted(time.Now().UTC())
+
+	if err := ctx.saveTable(models.GateDeviceTableName, gateDeviceData); err != nil {
+		return nil, err
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditDelete, models.GateDeviceTableName, id, before, nil)
+	resp.Message = "Success"
+	resp.Data = nil
+	return &resp, nil
+}
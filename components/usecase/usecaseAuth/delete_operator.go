@@ -0,0 +1,53 @@
+package usecaseAuth
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) DeleteOperator(dc contexts.BearerContext, req *request.DeleteOperatorRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "DeleteOperator")
+	defer end()
+	defer ctx.FileSystem.Lock(models.OperatorTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	operatorData := []models.Operator{}
+	if err := file.LoadTable(ctx.FileSystem, models.OperatorTableName, &operatorData); err != nil {
+		return nil, err
+	}
+
+	id, errConv := strconv.Atoi(req.OperatorId)
+	if errConv != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errConv.Error())
+	}
+	idx := findOperator(operatorData, id)
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Data Not Found")
+	}
+
+	before := toOperatorResponse(operatorData[idx])
+	operatorData[idx].MarkDeleted(time.Now().UTC())
+
+	if err := ctx.saveTable(models.OperatorTableName, operatorData); err != nil {
+		return nil, err
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditDelete, models.OperatorTableName, id, before, nil)
+	resp.Message = "Success"
+	resp.Data = nil
+	return &resp, nil
+}
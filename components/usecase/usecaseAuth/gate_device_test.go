@@ -0,0 +1,40 @@
+package usecaseAuth
+
+import (
+	"testing"
+
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+	validatorRequest "github.com/mhaikalla/parking-service-management-library/pkg/validator"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type GateDeviceSuite struct {
+	suite.Suite
+	usecase IUsecaseAuth
+	dc      contexts.BearerContext
+}
+
+func (s *GateDeviceSuite) SetupTest() {
+	s.usecase = NewAuthUsecase(file.NewFileSystem(s.T().TempDir() + "/"))
+}
+
+func (s *GateDeviceSuite) TestDefaultSite() {
+	req := request.CreateGateDeviceRequest{SiteId: 0, ClientId: "gate-1", Name: "Gate 1"}
+	validator := validatorRequest.NewValidator()
+	s.NoError(validator.Struct(req), "default site accepted")
+	s.Error(validator.Struct(request.CreateGateDeviceRequest{SiteId: -1, ClientId: "gate-1", Name: "Gate 1"}))
+
+	_, err := s.usecase.CreateGateDevice(s.dc, req)
+	s.Require().Nil(err)
+	devices, err := s.usecase.GetGateDevices(s.dc, &request.GetGateDeviceRequest{})
+	s.Require().Nil(err)
+	s.Require().Len(devices.Data, 1)
+	s.Equal(0, devices.Data[0].SiteId)
+}
+
+func TestGateDeviceSuite(t *testing.T) {
+	suite.Run(t, new(GateDeviceSuite))
+}
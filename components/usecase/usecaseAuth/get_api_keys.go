@@ -0,0 +1,52 @@
+package usecaseAuth
+
+import (
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// GetApiKeys list issued API keys including revoked ones, key itself never returned.
+func (ctx *usecaseObj) GetApiKeys(dc contexts.BearerContext, req *request.GetApiKeyRequest) (*response.GetApiKeysResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetApiKeys")
+	defer end()
+
+	resp := response.GetApiKeysResponse{}
+	apiKeyData := []models.ApiKey{}
+	resultData := []response.GetDetailApiKeyResponse{}
+
+	if err := file.LoadTable(ctx.FileSystem, models.ApiKeyTableName, &apiKeyData); err != nil {
+		return nil, err
+	}
+
+	for _, k := range apiKeyData {
+		if k.DeletedAt != nil {
+			continue
+		}
+		resultData = append(resultData, toApiKeyResponse(k))
+	}
+
+	resp.Data = resultData
+	return &resp, nil
+}
+
+func toApiKeyResponse(k models.ApiKey) response.GetDetailApiKeyResponse {
+	return response.GetDetailApiKeyResponse{
+		BaseResponse: response.BaseResponse{
+			Id:        k.Id,
+			CreatedAt: k.CreatedAt,
+			UpdatedAt: k.UpdatedAt,
+			Version:   k.Version,
+		},
+		SiteId:     k.SiteId,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		LastUsedAt: k.LastUsedAt,
+		ExpiredAt:  k.ExpiredAt,
+		RevokedAt:  k.RevokedAt,
+	}
+}
@@ -0,0 +1,48 @@
+package usecaseAuth
+
+import (
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) GetGateDevices(dc contexts.BearerContext, req *request.GetGateDeviceRequest) (*response.GetGateDevicesResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetGateDevices")
+	defer end()
+
+	resp := response.GetGateDevicesResponse{}
+	gateDeviceData := []models.GateDevice{}
+	resultData := []response.GetDetailGateDeviceResponse{}
+
+	if err := file.LoadTable(ctx.FileSystem, models.GateDeviceTableName, &gateDeviceData); err != nil {
+		return nil, err
+	}
+
+	for _, gd := range gateDeviceData {
+		if gd.DeletedAt != nil {
+			continue
+		}
+		resultData = append(resultData, toGateDeviceResponse(gd))
+	}
+
+	resp.Data = resultData
+	return &resp, nil
+}
+
+func toGateDeviceResponse(gd models.GateDevice) response.GetDetailGateDeviceResponse {
+	return response.GetDetailGateDeviceResponse{
+		BaseResponse: response.BaseResponse{
+			Id:        gd.Id,
+			CreatedAt: gd.CreatedAt,
+			UpdatedAt: gd.UpdatedAt,
+			Version:   gd.Version,
+		},
+		SiteId:   gd.SiteId,
+		ClientId: gd.ClientId,
+		Name:     gd.Name,
+		IsActive: gd.IsActive,
+	}
+}
@@ -0,0 +1,49 @@
+package usecaseAuth
+
+import (
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) GetOperators(dc contexts.BearerContext, req *request.GetOperatorRequest) (*response.GetOperatorsResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetOperators")
+	defer end()
+
+	resp := response.GetOperatorsResponse{}
+	operatorData := []models.Operator{}
+	resultData := []response.GetDetailOperatorResponse{}
+
+	if err := file.LoadTable(ctx.FileSystem, models.OperatorTableName, &operatorData); err != nil {
+		return nil, err
+	}
+
+	for _, op := range operatorData {
+		if op.DeletedAt != nil {
+			continue
+		}
+		resultData = append(resultData, toOperatorResponse(op))
+	}
+
+	resp.Data = resultData
+	return &resp, nil
+}
+
+func toOperatorResponse(op models.Operator) response.GetDetailOperatorResponse {
+	return response.GetDetailOperatorResponse{
+		BaseResponse: response.BaseResponse{
+			Id:        op.Id,
+			CreatedAt: op.CreatedAt,
+			UpdatedAt: op.UpdatedAt,
+			Version:   op.Version,
+		},
+		SiteId:   op.SiteId,
+		AllSites: op.AllSites,
+		Username: op.Username,
+		Roles:    op.Roles,
+		IsActive: op.IsActive,
+	}
+}
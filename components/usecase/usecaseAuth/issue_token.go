@@ -0,0 +1,275 @@
+package usecaseAuth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// tokenSubject identity token issued for.
+type tokenSubject struct {
+	Type     string
+	Id       int
+	ClientId string
+	SiteId   int
+	AllSites bool
+	Roles    []string
+}
+
+// IssueToken exchange operator password or gate device client secret for access and refresh token.
+func (ctx *usecaseObj) IssueToken(dc contexts.BearerContext, req *request.TokenRequest) (*response.TokenResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "IssueToken")
+	defer end()
+	defer ctx.FileSystem.Lock(models.RefreshTokenTableName)()
+
+	var subject *tokenSubject
+	var err *errs.Errs
+	switch req.GrantType {
+	case constant.GrantPassword:
+		subject, err = ctx.authenticateOperator(req.Username, req.Password)
+	default:
+		subject, err = ctx.authenticateGateDevice(req.ClientId, req.ClientSecret)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	refreshTokenData := []models.RefreshToken{}
+	if err := file.LoadTable(ctx.FileSystem, models.RefreshTokenTableName, &refreshTokenData); err != nil {
+		return nil, err
+	}
+	return ctx.issueTokenPair(*subject, uuid.New().String(), refreshTokenData)
+}
+
+// RefreshToken exchange refresh token for a new access and refresh token on same session, given refresh token revoked.
+func (ctx *usecaseObj) RefreshToken(dc contexts.BearerContext, req *request.RefreshTokenRequest) (*response.TokenResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "RefreshToken")
+	defer end()
+	defer ctx.FileSystem.Lock(models.RefreshTokenTableName)()
+
+	refreshTokenData := []models.RefreshToken{}
+	if err := file.LoadTable(ctx.FileSystem, models.RefreshTokenTableName, &refreshTokenData); err != nil {
+		return nil, err
+	}
+
+	dateNow := time.Now().UTC()
+	hash := hashToken(req.RefreshToken)
+	idx := -1
+	for i, rt := range refreshTokenData {
+		if rt.TokenHash == hash {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 || refreshTokenData[idx].RevokedAt != nil || dateNow.After(refreshTokenData[idx].ExpiredAt) {
+		return nil, invalidCredential("Invalid Refresh Token")
+	}
+	refreshTokenData[idx].RevokedAt = &dateNow
+	refreshTokenData[idx].Touch(dateNow)
+
+	rt := refreshTokenData[idx]
+	var subject *tokenSubject
+	var err *errs.Errs
+	if rt.SubjectType == constant.SubjectOperator {
+		subject, err = ctx.findOperatorSubject(rt.SubjectId)
+	} else {
+		subject, err = ctx.findGateDeviceSubject(rt.SubjectId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ctx.issueTokenPair(*subject, rt.SessionId, refreshTokenData)
+}
+
+// issueTokenPair sign access token for `subject` and store a new refresh token of `sessionId`.
+func (ctx *usecaseObj) issueTokenPair(subject tokenSubject, sessionId string, refreshTokenData []models.RefreshToken) (*response.TokenResponse, *errs.Errs) {
+	claims := ctx.JWT.NewClaims()
+	claims.Issuer = ctx.Token.Issuer
+	claims.Audience = ctx.Token.Audience
+	claims.Subject = subject.Type + ":" + strconv.Itoa(subject.Id)
+	claims.ClientID = subject.ClientId
+	claims.SessionID = sessionId
+	claims.SiteID = subject.SiteId
+	claims.AllSites = subject.AllSites
+	claims.Roles = subject.Roles
+
+	accessToken, errSign := ctx.JWT.WithStructClaims(claims)
+	if errSign != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errSign.Error())
+	}
+
+	refreshToken, errRand := randomToken(32)
+	if errRand != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errRand.Error())
+	}
+
+	dateNow := time.Now().UTC()
+	refreshTokenData = pruneRefreshTokens(refreshTokenData, dateNow)
+	refreshTokenData = append(refreshTokenData, models.RefreshToken{
+		BaseEntity: models.BaseEntity{
+			Id:        nextRefreshTokenId(refreshTokenData),
+			CreatedAt: dateNow,
+			UpdatedAt: dateNow,
+			DeletedAt: nil,
+		},
+		TokenHash:   hashToken(refreshToken),
+		SessionId:   sessionId,
+		SubjectType: subject.Type,
+		SubjectId:   subject.Id,
+		ExpiredAt:   dateNow.Add(ctx.Token.RefreshDuration),
+	})
+	if err := ctx.saveTable(models.RefreshTokenTableName, refreshTokenData); err != nil {
+		return nil, err
+	}
+
+	return &response.TokenResponse{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    ctx.JWT.Duration(),
+		RefreshToken: refreshToken,
+		Roles:        subject.Roles,
+		SiteId:       subject.SiteId,
+		AllSites:     subject.AllSites,
+	}, nil
+}
+
+func (ctx *usecaseObj) authenticateOperator(username, password string) (*tokenSubject, *errs.Errs) {
+	operatorData := []models.Operator{}
+	if err := file.LoadTable(ctx.FileSystem, models.OperatorTableName, &operatorData); err != nil {
+		return nil, err
+	}
+	for _, op := range operatorData {
+		if op.DeletedAt != nil || !strings.EqualFold(op.Username, username) {
+			continue
+		}
+		if !op.IsActive || bcrypt.CompareHashAndPassword([]byte(op.PasswordHash), []byte(password)) != nil {
+			break
+		}
+		return toOperatorSubject(op), nil
+	}
+	return nil, invalidCredential("Invalid Username Or Password")
+}
+
+func (ctx *usecaseObj) authenticateGateDevice(clientId, clientSecret string) (*tokenSubject, *errs.Errs) {
+	gateDeviceData := []models.GateDevice{}
+	if err := file.LoadTable(ctx.FileSystem, models.GateDeviceTableName, &gateDeviceData); err != nil {
+		return nil, err
+	}
+	for _, gd := range gateDeviceData {
+		if gd.DeletedAt != nil || gd.ClientId != clientId {
+			continue
+		}
+		if !gd.IsActive || bcrypt.CompareHashAndPassword([]byte(gd.SecretHash), []byte(clientSecret)) != nil {
+			break
+		}
+		return toGateDeviceSubject(gd), nil
+	}
+	return nil, invalidCredential("Invalid Client Credential")
+}
+
+// findOperatorSubject load current roles and site of operator, so refreshed token follow account changes.
+func (ctx *usecaseObj) findOperatorSubject(id int) (*tokenSubject, *errs.Errs) {
+	operatorData := []models.Operator{}
+	if err := file.LoadTable(ctx.FileSystem, models.OperatorTableName, &operatorData); err != nil {
+		return nil, err
+	}
+	for _, op := range operatorData {
+		if op.Id == id && op.DeletedAt == nil && op.IsActive {
+			return toOperatorSubject(op), nil
+		}
+	}
+	return nil, invalidCredential("Invalid Refresh Token")
+}
+
+func (ctx *usecaseObj) findGateDeviceSubject(id int) (*tokenSubject, *errs.Errs) {
+	gateDeviceData := []models.GateDevice{}
+	if err := file.LoadTable(ctx.FileSystem, models.GateDeviceTableName, &gateDeviceData); err != nil {
+		return nil, err
+	}
+	for _, gd := range gateDeviceData {
+		if gd.Id == id && gd.DeletedAt == nil && gd.IsActive {
+			return toGateDeviceSubject(gd), nil
+		}
+	}
+	return nil, invalidCredential("Invalid Refresh Token")
+}
+
+func toOperatorSubject(op models.Operator) *tokenSubject {
+	return &tokenSubject{
+		Type:     constant.SubjectOperator,
+		Id:       op.Id,
+		ClientId: op.Username,
+		SiteId:   op.SiteId,
+		AllSites: op.AllSites,
+		Roles:    op.Roles,
+	}
+}
+
+func toGateDeviceSubject(gd models.GateDevice) *tokenSubject {
+	return &tokenSubject{
+		Type:     constant.SubjectGateDevice,
+		Id:       gd.Id,
+		ClientId: gd.ClientId,
+		SiteId:   gd.SiteId,
+		Roles:    []string{constant.RoleGateDevice},
+	}
+}
+
+// pruneRefreshTokens drop refresh tokens already expired.
+func pruneRefreshTokens(refreshTokenData []models.RefreshToken, dateNow time.Time) []models.RefreshToken {
+	kept := refreshTokenData[:0]
+	for _, rt := range refreshTokenData {
+		if dateNow.Before(rt.ExpiredAt) {
+			kept = append(kept, rt)
+		}
+	}
+	return kept
+}
+
+func nextRefreshTokenId(refreshTokenData []models.RefreshToken) int {
+	id := 0
+	for _, rt := range refreshTokenData {
+		if rt.Id > id {
+			id = rt.Id
+		}
+	}
+	return id + 1
+}
+
+func invalidCredential(message string) *errs.Errs {
+	return errs.NewErrContext().
+		SetCode(errs.InvalidToken).
+		SetMessage(message)
+}
+
+func randomToken(length int) (string, error) {
+	buff := make([]byte, length)
+	if _, err := rand.Read(buff); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buff), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,74 @@
+package usecaseAuth
+
+import (
+	"errors"
+	"time"
+
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// apiKeyTouchInterval minimum interval between last used timestamp updates, avoid writing table on every request.
+const apiKeyTouchInterval = time.Minute
+
+// errInvalidApiKey returned when no valid API key match.
+var errInvalidApiKey = errors.New("invalid api key")
+
+// ResolveApiKey find valid API key matching `key` and record its last used timestamp.
+// Timestamp written under API key table lock on freshly loaded table, so key revoked or rotated meanwhile
+// is rejected and never brought back.
+func (ctx *usecaseObj) ResolveApiKey(key string) (*models.ApiKey, error) {
+	hash := hashToken(key)
+	apiKey, err := ctx.findApiKeyByHash(hash, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if !needTouch(apiKey) {
+		return apiKey, nil
+	}
+
+	defer ctx.FileSystem.Lock(models.ApiKeyTableName)()
+	apiKeyData := []models.ApiKey{}
+	if err := file.LoadTable(ctx.FileSystem, models.ApiKeyTableName, &apiKeyData); err != nil {
+		return nil, err
+	}
+	dateNow := time.Now().UTC()
+	idx := indexApiKeyByHash(apiKeyData, hash, dateNow)
+	if idx < 0 {
+		return nil, errInvalidApiKey
+	}
+	if needTouch(&apiKeyData[idx]) {
+		apiKeyData[idx].LastUsedAt = &dateNow
+		if err := ctx.saveTable(models.ApiKeyTableName, apiKeyData); err != nil {
+			return nil, err
+		}
+	}
+	return &apiKeyData[idx], nil
+}
+
+// findApiKeyByHash valid API key of hash `hash`, read without lock.
+func (ctx *usecaseObj) findApiKeyByHash(hash string, dateNow time.Time) (*models.ApiKey, error) {
+	apiKeyData := []models.ApiKey{}
+	if err := file.LoadTable(ctx.FileSystem, models.ApiKeyTableName, &apiKeyData); err != nil {
+		return nil, err
+	}
+	idx := indexApiKeyByHash(apiKeyData, hash, dateNow)
+	if idx < 0 {
+		return nil, errInvalidApiKey
+	}
+	return &apiKeyData[idx], nil
+}
+
+func indexApiKeyByHash(apiKeyData []models.ApiKey, hash string, dateNow time.Time) int {
+	for i, k := range apiKeyData {
+		if k.KeyHash == hash && k.IsValid(dateNow) {
+			return i
+		}
+	}
+	return -1
+}
+
+// needTouch whether last used timestamp of `k` older than `apiKeyTouchInterval`.
+func needTouch(k *models.ApiKey) bool {
+	return k.LastUsedAt == nil || time.Since(*k.LastUsedAt) >= apiKeyTouchInterval
+}
@@ -0,0 +1,45 @@
+package usecaseAuth
+
+import (
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) RevokeApiKey(dc contexts.BearerContext, req *request.RevokeApiKeyRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "RevokeApiKey")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ApiKeyTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	apiKeyData := []models.ApiKey{}
+	if err := file.LoadTable(ctx.FileSystem, models.ApiKeyTableName, &apiKeyData); err != nil {
+		return nil, err
+	}
+
+	dateNow := time.Now().UTC()
+	idx, err := findApiKey(apiKeyData, req.ApiKeyId, dateNow)
+	if err != nil {
+		return nil, err
+	}
+	before := toApiKeyResponse(apiKeyData[idx])
+	apiKeyData[idx].RevokedAt = &dateNow
+	apiKeyData[idx].Touch(dateNow)
+
+	if err := ctx.saveTable(models.ApiKeyTableName, apiKeyData); err != nil {
+		return nil, err
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditRevoke, models.ApiKeyTableName, before.Id, before, toApiKeyResponse(apiKeyData[idx]))
+	resp.Message = "Success"
+	resp.Data = nil
+	return &resp, nil
+}
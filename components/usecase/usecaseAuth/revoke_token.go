@@ -0,0 +1,103 @@
+package usecaseAuth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+	"github.com/mhaikalla/parking-service-management-library/pkg/jwt"
+)
+
+// RevokeToken add access token `jti` into denylist.
+// Revoking own token (empty `jti`) also end its session, every token and refresh token of the session no longer valid.
+// Revoking token of other caller only allowed for admin.
+func (ctx *usecaseObj) RevokeToken(dc contexts.BearerContext, req *request.RevokeTokenRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "RevokeToken")
+	defer end()
+	defer ctx.FileSystem.Lock(models.RefreshTokenTableName, models.RevokedTokenTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	claims := dc.SideLoad.BearerData
+	revoked := models.RevokedToken{JTI: req.JTI}
+	dateNow := time.Now().UTC()
+
+	if req.JTI == "" || req.JTI == claims.JWTID {
+		revoked.JTI = claims.JWTID
+		revoked.SessionId = claims.SessionID
+		revoked.ExpiredAt = time.Unix(claims.ExpiredAt, 0).UTC()
+	} else {
+		if !dc.HasRole(constant.RoleAdmin) {
+			return nil, errs.NewErrContext().
+				SetCode(errs.Forbidden).
+				SetMessage("Only Admin Can Revoke Other Token")
+		}
+		// expiry of other token unknown, keep entry for the longest token lifetime
+		revoked.ExpiredAt = dateNow.Add(time.Duration(ctx.JWT.Duration()) * time.Second)
+	}
+
+	revokedTokenData := []models.RevokedToken{}
+	if err := file.LoadTable(ctx.FileSystem, models.RevokedTokenTableName, &revokedTokenData); err != nil {
+		return nil, err
+	}
+	kept := revokedTokenData[:0]
+	id := 0
+	for _, rt := range revokedTokenData {
+		if dateNow.Before(rt.ExpiredAt) {
+			kept = append(kept, rt)
+		}
+		if rt.Id > id {
+			id = rt.Id
+		}
+	}
+	revoked.BaseEntity = models.BaseEntity{
+		Id:        id + 1,
+		CreatedAt: dateNow,
+		UpdatedAt: dateNow,
+		DeletedAt: nil,
+	}
+	if err := ctx.saveTable(models.RevokedTokenTableName, append(kept, revoked)); err != nil {
+		return nil, err
+	}
+
+	if revoked.SessionId != "" {
+		refreshTokenData := []models.RefreshToken{}
+		if err := file.LoadTable(ctx.FileSystem, models.RefreshTokenTableName, &refreshTokenData); err != nil {
+			return nil, err
+		}
+		for i := range refreshTokenData {
+			if refreshTokenData[i].SessionId == revoked.SessionId && refreshTokenData[i].RevokedAt == nil {
+				refreshTokenData[i].RevokedAt = &dateNow
+				refreshTokenData[i].Touch(dateNow)
+			}
+		}
+		if err := ctx.saveTable(models.RefreshTokenTableName, refreshTokenData); err != nil {
+			return nil, err
+		}
+	}
+
+	resp.Message = "Success"
+	resp.Data = nil
+	return &resp, nil
+}
+
+// IsTokenRevoked return error when token `jti` or its session found on denylist.
+func (ctx *usecaseObj) IsTokenRevoked(claims jwt.JWTClaims) error {
+	revokedTokenData := []models.RevokedToken{}
+	if err := file.LoadTable(ctx.FileSystem, models.RevokedTokenTableName, &revokedTokenData); err != nil {
+		return err
+	}
+	for _, rt := range revokedTokenData {
+		if rt.JTI == claims.JWTID || (rt.SessionId != "" && rt.SessionId == claims.SessionID) {
+			return errors.New("token is revoked")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package usecaseAuth
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// RotateApiKey issue a new key with same name, site and scopes.
+// Old key revoked right away, or expired after grace period when given.
+func (ctx *usecaseObj) RotateApiKey(dc contexts.BearerContext, req request.RotateApiKeyRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "RotateApiKey")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ApiKeyTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	apiKeyData := []models.ApiKey{}
+	if err := file.LoadTable(ctx.FileSystem, models.ApiKeyTableName, &apiKeyData); err != nil {
+		return nil, err
+	}
+
+	dateNow := time.Now().UTC()
+	idx, err := findApiKey(apiKeyData, req.ApiKeyId, dateNow)
+	if err != nil {
+		return nil, err
+	}
+
+	old := apiKeyData[idx]
+	if req.GracePeriod > 0 {
+		expiredAt := dateNow.Add(time.Duration(req.GracePeriod) * time.Second)
+		apiKeyData[idx].ExpiredAt = &expiredAt
+	} else {
+		apiKeyData[idx].RevokedAt = &dateNow
+	}
+	apiKeyData[idx].Touch(dateNow)
+
+	apiKeyData, created, err := appendApiKey(apiKeyData, old.SiteId, old.Name, old.Scopes, dateNow)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.saveTable(models.ApiKeyTableName, apiKeyData); err != nil {
+		return nil, err
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditRotate, models.ApiKeyTableName, old.Id, toApiKeyResponse(old), toApiKeyResponse(apiKeyData[idx]))
+	audit.Record(ctx.Audit, dc, constant.AuditCreate, models.ApiKeyTableName, created.Id, nil, created.GetDetailApiKeyResponse)
+	resp.Message = "Success"
+	resp.Data = created
+	return &resp, nil
+}
+
+// findApiKey return index of valid API key `id`.
+func findApiKey(apiKeyData []models.ApiKey, id string, dateNow time.Time) (int, *errs.Errs) {
+	keyId, errConv := strconv.Atoi(id)
+	if errConv != nil {
+		return -1, errs.NewErrContext().
+			SetCode(errs.BadRequest).
+			SetMessage("Invalid API Key ID")
+	}
+	for i, k := range apiKeyData {
+		if k.Id == keyId && k.IsValid(dateNow) {
+			return i, nil
+		}
+	}
+	return -1, errs.NewErrContext().
+		SetCode(errs.NotFound).
+		SetMessage("Data Not Found")
+}
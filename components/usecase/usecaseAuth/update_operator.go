@@ -0,0 +1,87 @@
+package usecaseAuth
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// UpdateOperator change operator roles, site and status, password changed only when given.
+// Refreshed token follow the new roles and site.
+func (ctx *usecaseObj) UpdateOperator(dc contexts.BearerContext, req request.UpdateOperatorRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "UpdateOperator")
+	defer end()
+	defer ctx.FileSystem.Lock(models.OperatorTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	operatorData := []models.Operator{}
+	if err := file.LoadTable(ctx.FileSystem, models.OperatorTableName, &operatorData); err != nil {
+		return nil, err
+	}
+
+	id, errConv := strconv.Atoi(req.Id)
+	if errConv != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errConv.Error())
+	}
+	idx := findOperator(operatorData, id)
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Data Not Found")
+	}
+	if !operatorData[idx].MatchVersion(req.Version) {
+		resp.Message = "Conflict, Data Has Been Changed"
+		resp.Data = toOperatorResponse(operatorData[idx])
+		return &resp, errs.NewErrContext().
+			SetCode(errs.Conflict).
+			SetMessage("Data Has Been Changed, Reload And Retry")
+	}
+
+	before := toOperatorResponse(operatorData[idx])
+	if req.Password != "" {
+		hash, errHash := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+		if errHash != nil {
+			return nil, errs.NewErrContext().
+				SetCode(errs.InternalServerError).
+				SetMessage(errHash.Error())
+		}
+		operatorData[idx].PasswordHash = string(hash)
+	}
+	operatorData[idx].SiteId = req.SiteId
+	operatorData[idx].AllSites = req.AllSites
+	operatorData[idx].Roles = req.Roles
+	operatorData[idx].IsActive = *req.IsActive
+	operatorData[idx].Touch(time.Now().UTC())
+
+	if err := ctx.saveTable(models.OperatorTableName, operatorData); err != nil {
+		return nil, err
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditUpdate, models.OperatorTableName, id, before, toOperatorResponse(operatorData[idx]))
+	resp.Message = "Success"
+	resp.Data = toOperatorResponse(operatorData[idx])
+	return &resp, nil
+}
+
+// findOperator return index of operator `id`, -1 when not found.
+func findOperator(operatorData []models.Operator, id int) int {
+	for i, op := range operatorData {
+		if op.Id == id && op.DeletedAt == nil {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,98 @@
+package usecaseAuth
+
+import (
+	"time"
+
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+	"github.com/mhaikalla/parking-service-management-library/pkg/jwt"
+)
+
+type IUsecaseAuth interface {
+	IssueToken(dc contexts.BearerContext, req *request.TokenRequest) (*response.TokenResponse, *errs.Errs)
+	RefreshToken(dc contexts.BearerContext, req *request.RefreshTokenRequest) (*response.TokenResponse, *errs.Errs)
+	RevokeToken(dc contexts.BearerContext, req *request.RevokeTokenRequest) (*response.BaseMessageResponse, *errs.Errs)
+	IsTokenRevoked(claims jwt.JWTClaims) error
+
+	CreateOperator(dc contexts.BearerContext, req request.CreateOperatorRequest) (*response.BaseMessageResponse, *errs.Errs)
+	UpdateOperator(dc contexts.BearerContext, req request.UpdateOperatorRequest) (*response.BaseMessageResponse, *errs.Errs)
+	DeleteOperator(dc contexts.BearerContext, req *request.DeleteOperatorRequest) (*response.BaseMessageResponse, *errs.Errs)
+	GetOperators(dc contexts.BearerContext, req *request.GetOperatorRequest) (*response.GetOperatorsResponse, *errs.Errs)
+	EnsureBootstrapAdmin(username, password string) error
+
+	CreateGateDevice(dc contexts.BearerContext, req request.CreateGateDeviceRequest) (*response.BaseMessageResponse, *errs.Errs)
+	DeleteGateDevice(dc contexts.BearerContext, req *request.DeleteGateDeviceRequest) (*response.BaseMessageResponse, *errs.Errs)
+	GetGateDevices(dc contexts.BearerContext, req *request.GetGateDeviceRequest) (*response.GetGateDevicesResponse, *errs.Errs)
+
+	CreateApiKey(dc contexts.BearerContext, req request.CreateApiKeyRequest) (*response.BaseMessageResponse, *errs.Errs)
+	RotateApiKey(dc contexts.BearerContext, req request.RotateApiKeyRequest) (*response.BaseMessageResponse, *errs.Errs)
+	RevokeApiKey(dc contexts.BearerContext, req *request.RevokeApiKeyRequest) (*response.BaseMessageResponse, *errs.Errs)
+	GetApiKeys(dc contexts.BearerContext, req *request.GetApiKeyRequest) (*response.GetApiKeysResponse, *errs.Errs)
+	ResolveApiKey(key string) (*models.ApiKey, error)
+}
+
+// TokenConfig configure token issued by token endpoint.
+type TokenConfig struct {
+	Issuer          string
+	Audience        string
+	RefreshDuration time.Duration
+}
+
+type usecaseObj struct {
+	FileSystem file.IFileSystem
+	Audit      audit.Trail
+	JWT        jwt.IJWT
+	Token      TokenConfig
+}
+
+// NewTokenConfig token configured by `auth.token` entry.
+func NewTokenConfig(conf config.TokenConfig) TokenConfig {
+	return TokenConfig{
+		Issuer:          conf.Issuer,
+		Audience:        conf.Audience,
+		RefreshDuration: time.Duration(conf.RefreshDuration) * time.Second,
+	}
+}
+
+func NewAuthUsecase(ctx ...interface{}) IUsecaseAuth {
+	handle := usecaseObj{}
+	for _, c := range ctx {
+		switch c.(type) {
+		case file.IFileSystem:
+			handle.FileSystem = c.(file.IFileSystem)
+		case audit.Trail:
+			handle.Audit = c.(audit.Trail)
+		case jwt.IJWT:
+			handle.JWT = c.(jwt.IJWT)
+		case TokenConfig:
+			handle.Token = c.(TokenConfig)
+		}
+	}
+	return &handle
+}
+
+// saveTable save `data` into json table.
+func (ctx *usecaseObj) saveTable(tableName string, data interface{}) *errs.Errs {
+	stat, err := ctx.FileSystem.SaveData(tableName, data)
+	if !stat && err != nil {
+		return errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	return nil
+}
+
+// traced copy of usecase with storage traced under span `name`, span ended by returned func.
+func (ctx *usecaseObj) traced(dc contexts.BearerContext, name string) (*usecaseObj, func()) {
+	spanCtx, end := dc.StartSpan("UsecaseAuth." + name)
+	traced := *ctx
+	traced.FileSystem = file.WithTracing(ctx.FileSystem, spanCtx)
+	return &traced, end
+}
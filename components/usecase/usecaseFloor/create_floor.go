@@ -0,0 +1,63 @@
+package usecaseFloor
+
+import (
+	"strings"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) CreateFloor(dc contexts.BearerContext, req request.CreateFloorRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "CreateFloor")
+	defer end()
+	defer ctx.FileSystem.Lock(models.FloorTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	floorData := []models.Floor{}
+	if err := file.LoadTable(ctx.FileSystem, models.FloorTableName, &floorData); err != nil {
+		return nil, err
+	}
+
+	siteId := dc.GetSiteID()
+	for _, fl := range floorData {
+		if fl.SiteId == siteId && fl.DeletedAt == nil && strings.EqualFold(fl.Name, req.Name) {
+			return nil, errs.NewErrContext().
+				SetCode(errs.Conflict).
+				SetMessage("Floor Name Already Exists")
+		}
+	}
+
+	dateNow := time.Now().UTC()
+	floorData = append(floorData, models.Floor{
+		BaseEntity: models.BaseEntity{
+			Id:        len(floorData) + 1,
+			CreatedAt: dateNow,
+			UpdatedAt: dateNow,
+			DeletedAt: nil,
+		},
+		SiteId:   siteId,
+		Name:     req.Name,
+		Level:    req.Level,
+		Capacity: req.Capacity,
+	})
+	stat, err := ctx.FileSystem.SaveData(models.FloorTableName, floorData)
+	if !stat && err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	created := floorData[len(floorData)-1]
+	audit.Record(ctx.Audit, dc, constant.AuditCreate, models.FloorTableName, created.Id, nil, created)
+	resp.Message = "Success"
+	resp.Data = req
+	return &resp, nil
+}
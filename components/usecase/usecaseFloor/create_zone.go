@@ -0,0 +1,71 @@
+package usecaseFloor
+
+import (
+	"strings"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) CreateZone(dc contexts.BearerContext, req request.CreateZoneRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "CreateZone")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ZoneTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	floorData := []models.Floor{}
+	zoneData := []models.Zone{}
+	if err := file.LoadTable(ctx.FileSystem, models.FloorTableName, &floorData); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.ZoneTableName, &zoneData); err != nil {
+		return nil, err
+	}
+
+	siteId := dc.GetSiteID()
+	if findFloor(floorData, siteId, req.FloorId) < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Floor Data Not Found")
+	}
+	for _, zn := range zoneData {
+		if zn.FloorId == req.FloorId && zn.SiteId == siteId && zn.DeletedAt == nil && strings.EqualFold(zn.Name, req.Name) {
+			return nil, errs.NewErrContext().
+				SetCode(errs.Conflict).
+				SetMessage("Zone Name Already Exists")
+		}
+	}
+
+	dateNow := time.Now().UTC()
+	zoneData = append(zoneData, models.Zone{
+		BaseEntity: models.BaseEntity{
+			Id:        len(zoneData) + 1,
+			CreatedAt: dateNow,
+			UpdatedAt: dateNow,
+			DeletedAt: nil,
+		},
+		SiteId:  siteId,
+		FloorId: req.FloorId,
+		Name:    req.Name,
+	})
+	stat, err := ctx.FileSystem.SaveData(models.ZoneTableName, zoneData)
+	if !stat && err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	created := zoneData[len(zoneData)-1]
+	audit.Record(ctx.Audit, dc, constant.AuditCreate, models.ZoneTableName, created.Id, nil, created)
+	resp.Message = "Success"
+	resp.Data = req
+	return &resp, nil
+}
@@ -0,0 +1,93 @@
+package usecaseFloor
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) DeleteFloor(dc contexts.BearerContext, req *request.DeleteFloorRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "DeleteFloor")
+	defer end()
+	defer ctx.FileSystem.Lock(models.FloorTableName, models.ZoneTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	floorData := []models.Floor{}
+	zoneData := []models.Zone{}
+	parkingLotData := []models.ParkingLot{}
+	if err := file.LoadTable(ctx.FileSystem, models.FloorTableName, &floorData); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.ZoneTableName, &zoneData); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingLotTableName, &parkingLotData); err != nil {
+		return nil, err
+	}
+
+	id, errConv := strconv.Atoi(req.FloorId)
+	if errConv != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errConv.Error())
+	}
+
+	siteId := dc.GetSiteID()
+	idx := findFloor(floorData, siteId, id)
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Data Not Found")
+	}
+	for _, pl := range parkingLotData {
+		if pl.FloorId == id && pl.SiteId == siteId && pl.DeletedAt == nil {
+			return nil, errs.NewErrContext().
+				SetCode(errs.BadRequest).
+				SetMessage("This Floor Still Has Parking Area")
+		}
+	}
+
+	dateNow := time.Now().UTC()
+	before := floorData[idx]
+	floorData[idx].MarkDeleted(dateNow)
+
+	deletedZones := []models.Zone{}
+	for i, zn := range zoneData {
+		if zn.FloorId == id && zn.SiteId == siteId && zn.DeletedAt == nil {
+			deletedZones = append(deletedZones, zn)
+			zoneData[i].MarkDeleted(dateNow)
+		}
+	}
+
+	stat, err := ctx.FileSystem.SaveData(models.FloorTableName, floorData)
+	if !stat && err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	if len(deletedZones) > 0 {
+		stat, err = ctx.FileSystem.SaveData(models.ZoneTableName, zoneData)
+		if !stat && err != nil {
+			return nil, errs.NewErrContext().
+				SetCode(errs.InternalServerError).
+				SetMessage(err.Error())
+		}
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditDelete, models.FloorTableName, before.Id, before, nil)
+	for _, zn := range deletedZones {
+		audit.Record(ctx.Audit, dc, constant.AuditDelete, models.ZoneTableName, zn.Id, zn, nil)
+	}
+	resp.Message = "Success"
+	resp.Data = nil
+	return &resp, nil
+}
@@ -0,0 +1,69 @@
+package usecaseFloor
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) DeleteZone(dc contexts.BearerContext, req *request.DeleteZoneRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "DeleteZone")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ZoneTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	zoneData := []models.Zone{}
+	parkingLotData := []models.ParkingLot{}
+	if err := file.LoadTable(ctx.FileSystem, models.ZoneTableName, &zoneData); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingLotTableName, &parkingLotData); err != nil {
+		return nil, err
+	}
+
+	id, errConv := strconv.Atoi(req.ZoneId)
+	if errConv != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errConv.Error())
+	}
+
+	siteId := dc.GetSiteID()
+	idx := findZone(zoneData, siteId, id)
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Data Not Found")
+	}
+	for _, pl := range parkingLotData {
+		if pl.ZoneId == id && pl.SiteId == siteId && pl.DeletedAt == nil {
+			return nil, errs.NewErrContext().
+				SetCode(errs.BadRequest).
+				SetMessage("This Zone Still Has Parking Area")
+		}
+	}
+
+	before := zoneData[idx]
+	zoneData[idx].MarkDeleted(time.Now().UTC())
+
+	stat, err := ctx.FileSystem.SaveData(models.ZoneTableName, zoneData)
+	if !stat && err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditDelete, models.ZoneTableName, before.Id, before, nil)
+	resp.Message = "Success"
+	resp.Data = nil
+	return &resp, nil
+}
@@ -0,0 +1,39 @@
+package usecaseFloor
+
+import (
+	"strconv"
+
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) GetDetailFloor(dc contexts.BearerContext, req *request.GetDetailFloorRequest) (*response.GetDetailFloorResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetDetailFloor")
+	defer end()
+
+	floorData := []models.Floor{}
+	if err := file.LoadTable(ctx.FileSystem, models.FloorTableName, &floorData); err != nil {
+		return nil, err
+	}
+
+	id, errConv := strconv.Atoi(req.FloorId)
+	if errConv != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errConv.Error())
+	}
+
+	idx := findFloor(floorData, dc.GetSiteID(), id)
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Data Not Found")
+	}
+
+	resp := toFloorResponse(floorData[idx])
+	return &resp, nil
+}
@@ -0,0 +1,39 @@
+package usecaseFloor
+
+import (
+	"strconv"
+
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) GetDetailZone(dc contexts.BearerContext, req *request.GetDetailZoneRequest) (*response.GetDetailZoneResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetDetailZone")
+	defer end()
+
+	zoneData := []models.Zone{}
+	if err := file.LoadTable(ctx.FileSystem, models.ZoneTableName, &zoneData); err != nil {
+		return nil, err
+	}
+
+	id, errConv := strconv.Atoi(req.ZoneId)
+	if errConv != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errConv.Error())
+	}
+
+	idx := findZone(zoneData, dc.GetSiteID(), id)
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Data Not Found")
+	}
+
+	resp := toZoneResponse(zoneData[idx])
+	return &resp, nil
+}
@@ -0,0 +1,89 @@
+package usecaseFloor
+
+import (
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// GetFloorOccupancy report parking lot usage per floor ordered by floor level.
+// Closed floor has no available parking lot, lot out of service counted by its status.
+func (ctx *usecaseObj) GetFloorOccupancy(dc contexts.BearerContext) (*response.GetFloorOccupancyResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetFloorOccupancy")
+	defer end()
+
+	floorData, parkingLotData, windowData, err := ctx.loadOccupancyTables()
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.GetFloorOccupancyResponse{
+		Data: floorOccupancies(floorData, parkingLotData, windowData, dc.GetSiteID(), time.Now().UTC()),
+	}, nil
+}
+
+func (ctx *usecaseObj) loadOccupancyTables() ([]models.Floor, []models.ParkingLot, []models.MaintenanceWindow, *errs.Errs) {
+	floorData := []models.Floor{}
+	parkingLotData := []models.ParkingLot{}
+	windowData := []models.MaintenanceWindow{}
+	if err := file.LoadTable(ctx.FileSystem, models.FloorTableName, &floorData); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingLotTableName, &parkingLotData); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.MaintenanceWindowTableName, &windowData); err != nil {
+		return nil, nil, nil, err
+	}
+	return floorData, parkingLotData, windowData, nil
+}
+
+// floorOccupancies parking lot usage per floor of `siteId` at `dateNow`.
+func floorOccupancies(
+	floorData []models.Floor,
+	parkingLotData []models.ParkingLot,
+	windowData []models.MaintenanceWindow,
+	siteId int,
+	dateNow time.Time,
+) []response.FloorOccupancyResponse {
+	occupancies := []response.FloorOccupancyResponse{}
+	for _, fl := range sortFloors(floorData) {
+		if fl.DeletedAt != nil || fl.SiteId != siteId {
+			continue
+		}
+		occupancy := response.FloorOccupancyResponse{
+			FloorId:  fl.Id,
+			Name:     fl.Name,
+			Level:    fl.Level,
+			Capacity: fl.Capacity,
+			IsClosed: fl.IsClosed,
+		}
+		for _, pl := range parkingLotData {
+			if pl.FloorId != fl.Id || pl.SiteId != siteId || pl.DeletedAt != nil {
+				continue
+			}
+			occupancy.TotalParkingLot++
+			switch pl.CurrentStatus(windowData, dateNow) {
+			case constant.LotOccupied:
+				occupancy.OccupiedParkingLot++
+			case constant.LotMaintenance:
+				occupancy.MaintenanceParkingLot++
+			case constant.LotReserved:
+				occupancy.ReservedParkingLot++
+			case constant.LotDisabled:
+				occupancy.DisabledParkingLot++
+			default:
+				if !fl.IsClosed {
+					occupancy.AvailableParkingLot++
+				}
+			}
+		}
+		occupancies = append(occupancies, occupancy)
+	}
+	return occupancies
+}
@@ -0,0 +1,62 @@
+package usecaseFloor
+
+import (
+	"sort"
+
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) GetFloors(dc contexts.BearerContext, req *request.GetFloorRequest) (*response.GetFloorsResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetFloors")
+	defer end()
+
+	resp := response.GetFloorsResponse{}
+	floorData := []models.Floor{}
+	resultData := []response.GetDetailFloorResponse{}
+
+	if err := file.LoadTable(ctx.FileSystem, models.FloorTableName, &floorData); err != nil {
+		return nil, err
+	}
+
+	for _, fl := range sortFloors(floorData) {
+		if fl.DeletedAt != nil || fl.SiteId != dc.GetSiteID() {
+			continue
+		}
+		resultData = append(resultData, toFloorResponse(fl))
+	}
+
+	resp.Data = resultData
+	return &resp, nil
+}
+
+// sortFloors return copy of floors ordered by level, floor created first wins on the same level.
+func sortFloors(floors []models.Floor) []models.Floor {
+	sorted := make([]models.Floor, len(floors))
+	copy(sorted, floors)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Level < sorted[j].Level
+	})
+	return sorted
+}
+
+func toFloorResponse(fl models.Floor) response.GetDetailFloorResponse {
+	return response.GetDetailFloorResponse{
+		BaseResponse: response.BaseResponse{
+			Id:        fl.Id,
+			CreatedAt: fl.CreatedAt,
+			UpdatedAt: fl.UpdatedAt,
+			Version:   fl.Version,
+		},
+		SiteId:       fl.SiteId,
+		Name:         fl.Name,
+		Level:        fl.Level,
+		Capacity:     fl.Capacity,
+		IsClosed:     fl.IsClosed,
+		ClosedReason: fl.ClosedReason,
+	}
+}
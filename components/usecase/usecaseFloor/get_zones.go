@@ -0,0 +1,50 @@
+package usecaseFloor
+
+import (
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) GetZones(dc contexts.BearerContext, req *request.GetZoneRequest) (*response.GetZonesResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetZones")
+	defer end()
+
+	resp := response.GetZonesResponse{}
+	zoneData := []models.Zone{}
+	resultData := []response.GetDetailZoneResponse{}
+
+	if err := file.LoadTable(ctx.FileSystem, models.ZoneTableName, &zoneData); err != nil {
+		return nil, err
+	}
+
+	for _, zn := range zoneData {
+		if zn.DeletedAt != nil || zn.SiteId != dc.GetSiteID() {
+			continue
+		}
+		if req.FloorId != 0 && zn.FloorId != req.FloorId {
+			continue
+		}
+		resultData = append(resultData, toZoneResponse(zn))
+	}
+
+	resp.Data = resultData
+	return &resp, nil
+}
+
+func toZoneResponse(zn models.Zone) response.GetDetailZoneResponse {
+	return response.GetDetailZoneResponse{
+		BaseResponse: response.BaseResponse{
+			Id:        zn.Id,
+			CreatedAt: zn.CreatedAt,
+			UpdatedAt: zn.UpdatedAt,
+			Version:   zn.Version,
+		},
+		SiteId:  zn.SiteId,
+		FloorId: zn.FloorId,
+		Name:    zn.Name,
+	}
+}
@@ -0,0 +1,45 @@
+package usecaseFloor
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/metrics"
+)
+
+var (
+	floorParkingLots = metrics.Default.NewGauge("parking_floor_lots",
+		"Parking lots on floor.", "site_id", "floor_id", "floor")
+	floorOccupiedLots = metrics.Default.NewGauge("parking_floor_occupied_lots",
+		"Occupied parking lots on floor.", "site_id", "floor_id", "floor")
+	floorAvailableLots = metrics.Default.NewGauge("parking_floor_available_lots",
+		"Parking lots available to park on floor.", "site_id", "floor_id", "floor")
+)
+
+// RefreshOccupancyMetrics recompute occupancy gauges of every floor of every site, deleted floor dropped.
+func (ctx *usecaseObj) RefreshOccupancyMetrics() *errs.Errs {
+	floorData, parkingLotData, windowData, err := ctx.loadOccupancyTables()
+	if err != nil {
+		return err
+	}
+
+	sites := map[int]bool{}
+	for _, fl := range floorData {
+		sites[fl.SiteId] = true
+	}
+
+	floorParkingLots.Reset()
+	floorOccupiedLots.Reset()
+	floorAvailableLots.Reset()
+	dateNow := time.Now().UTC()
+	for siteId := range sites {
+		for _, occupancy := range floorOccupancies(floorData, parkingLotData, windowData, siteId, dateNow) {
+			labels := []string{strconv.Itoa(siteId), strconv.Itoa(occupancy.FloorId), occupancy.Name}
+			floorParkingLots.Set(float64(occupancy.TotalParkingLot), labels...)
+			floorOccupiedLots.Set(float64(occupancy.OccupiedParkingLot), labels...)
+			floorAvailableLots.Set(float64(occupancy.AvailableParkingLot), labels...)
+		}
+	}
+	return nil
+}
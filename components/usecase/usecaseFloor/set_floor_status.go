@@ -0,0 +1,73 @@
+package usecaseFloor
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// SetFloorStatus open or close a whole floor, parking lot on closed floor excluded from allocation.
+// Vehicles already parked on the floor can still leave.
+func (ctx *usecaseObj) SetFloorStatus(dc contexts.BearerContext, req request.SetFloorStatusRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "SetFloorStatus")
+	defer end()
+	defer ctx.FileSystem.Lock(models.FloorTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	floorData := []models.Floor{}
+	if err := file.LoadTable(ctx.FileSystem, models.FloorTableName, &floorData); err != nil {
+		return nil, err
+	}
+
+	id, errConv := strconv.Atoi(req.FloorId)
+	if errConv != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errConv.Error())
+	}
+
+	idx := findFloor(floorData, dc.GetSiteID(), id)
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Data Not Found")
+	}
+	if !floorData[idx].MatchVersion(req.Version) {
+		resp.Message = "Conflict, Data Has Been Changed"
+		resp.Data = toFloorResponse(floorData[idx])
+		return &resp, errs.NewErrContext().
+			SetCode(errs.Conflict).
+			SetMessage("Data Has Been Changed, Reload And Retry")
+	}
+
+	before := floorData[idx]
+	floorData[idx].Touch(time.Now().UTC())
+	floorData[idx].IsClosed = *req.IsClosed
+	floorData[idx].ClosedReason = ""
+	if *req.IsClosed {
+		floorData[idx].ClosedReason = req.Reason
+	}
+	version := floorData[idx].Version
+	req.Version = &version
+
+	stat, err := ctx.FileSystem.SaveData(models.FloorTableName, floorData)
+	if !stat && err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditUpdate, models.FloorTableName, before.Id, before, floorData[idx])
+	resp.Message = "Success, Data Updated"
+	resp.Data = req
+	return &resp, nil
+}
@@ -0,0 +1,113 @@
+package usecaseFloor
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) UpdateFloor(dc contexts.BearerContext, req request.UpdateFloorRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "UpdateFloor")
+	defer end()
+	defer ctx.FileSystem.Lock(models.FloorTableName, models.ParkingLotTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	floorData := []models.Floor{}
+	parkingLotData := []models.ParkingLot{}
+	if err := file.LoadTable(ctx.FileSystem, models.FloorTableName, &floorData); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingLotTableName, &parkingLotData); err != nil {
+		return nil, err
+	}
+
+	id, errConv := strconv.Atoi(req.Id)
+	if errConv != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errConv.Error())
+	}
+
+	siteId := dc.GetSiteID()
+	idx := findFloor(floorData, siteId, id)
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Data Not Found")
+	}
+	if !floorData[idx].MatchVersion(req.Version) {
+		resp.Message = "Conflict, Data Has Been Changed"
+		resp.Data = toFloorResponse(floorData[idx])
+		return &resp, errs.NewErrContext().
+			SetCode(errs.Conflict).
+			SetMessage("Data Has Been Changed, Reload And Retry")
+	}
+	for _, fl := range floorData {
+		if fl.Id != id && fl.SiteId == siteId && fl.DeletedAt == nil && strings.EqualFold(fl.Name, req.Name) {
+			return nil, errs.NewErrContext().
+				SetCode(errs.Conflict).
+				SetMessage("Floor Name Already Exists")
+		}
+	}
+
+	totalParkingLot := 0
+	for _, pl := range parkingLotData {
+		if pl.FloorId == id && pl.SiteId == siteId && pl.DeletedAt == nil {
+			totalParkingLot++
+		}
+	}
+	if req.Capacity > 0 && totalParkingLot > req.Capacity {
+		return nil, errs.NewErrContext().
+			SetCode(errs.BadRequest).
+			SetMessage("Floor Capacity Is Less Than Its Parking Area")
+	}
+
+	dateNow := time.Now().UTC()
+	before := floorData[idx]
+	floorData[idx].Touch(dateNow)
+	floorData[idx].Name = req.Name
+	floorData[idx].Level = req.Level
+	floorData[idx].Capacity = req.Capacity
+	version := floorData[idx].Version
+	req.Version = &version
+
+	// parking lot keep floor name for display, keep it in sync with renamed floor
+	lotChanged := false
+	for i, pl := range parkingLotData {
+		if pl.FloorId == id && pl.SiteId == siteId && pl.Floor != req.Name {
+			parkingLotData[i].Floor = req.Name
+			parkingLotData[i].Touch(dateNow)
+			lotChanged = true
+		}
+	}
+
+	stat, err := ctx.FileSystem.SaveData(models.FloorTableName, floorData)
+	if !stat && err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	if lotChanged {
+		stat, err = ctx.FileSystem.SaveData(models.ParkingLotTableName, parkingLotData)
+		if !stat && err != nil {
+			return nil, errs.NewErrContext().
+				SetCode(errs.InternalServerError).
+				SetMessage(err.Error())
+		}
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditUpdate, models.FloorTableName, before.Id, before, floorData[idx])
+	resp.Message = "Success, Data Updated"
+	resp.Data = req
+	return &resp, nil
+}
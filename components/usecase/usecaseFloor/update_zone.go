@@ -0,0 +1,99 @@
+package usecaseFloor
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) UpdateZone(dc contexts.BearerContext, req request.UpdateZoneRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "UpdateZone")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ZoneTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	floorData := []models.Floor{}
+	zoneData := []models.Zone{}
+	parkingLotData := []models.ParkingLot{}
+	if err := file.LoadTable(ctx.FileSystem, models.FloorTableName, &floorData); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.ZoneTableName, &zoneData); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingLotTableName, &parkingLotData); err != nil {
+		return nil, err
+	}
+
+	id, errConv := strconv.Atoi(req.Id)
+	if errConv != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errConv.Error())
+	}
+
+	siteId := dc.GetSiteID()
+	idx := findZone(zoneData, siteId, id)
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Data Not Found")
+	}
+	if !zoneData[idx].MatchVersion(req.Version) {
+		resp.Message = "Conflict, Data Has Been Changed"
+		resp.Data = toZoneResponse(zoneData[idx])
+		return &resp, errs.NewErrContext().
+			SetCode(errs.Conflict).
+			SetMessage("Data Has Been Changed, Reload And Retry")
+	}
+	if findFloor(floorData, siteId, req.FloorId) < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Floor Data Not Found")
+	}
+	for _, zn := range zoneData {
+		if zn.Id != id && zn.FloorId == req.FloorId && zn.SiteId == siteId && zn.DeletedAt == nil && strings.EqualFold(zn.Name, req.Name) {
+			return nil, errs.NewErrContext().
+				SetCode(errs.Conflict).
+				SetMessage("Zone Name Already Exists")
+		}
+	}
+	if zoneData[idx].FloorId != req.FloorId {
+		for _, pl := range parkingLotData {
+			if pl.ZoneId == id && pl.SiteId == siteId && pl.DeletedAt == nil {
+				return nil, errs.NewErrContext().
+					SetCode(errs.BadRequest).
+					SetMessage("This Zone Still Has Parking Area")
+			}
+		}
+	}
+
+	before := zoneData[idx]
+	zoneData[idx].Touch(time.Now().UTC())
+	zoneData[idx].FloorId = req.FloorId
+	zoneData[idx].Name = req.Name
+	version := zoneData[idx].Version
+	req.Version = &version
+
+	stat, err := ctx.FileSystem.SaveData(models.ZoneTableName, zoneData)
+	if !stat && err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditUpdate, models.ZoneTableName, before.Id, before, zoneData[idx])
+	resp.Message = "Success, Data Updated"
+	resp.Data = req
+	return &resp, nil
+}
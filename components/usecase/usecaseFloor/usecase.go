@@ -0,0 +1,74 @@
+package usecaseFloor
+
+import (
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+)
+
+type IUsecaseFloor interface {
+	CreateFloor(dc contexts.BearerContext, req request.CreateFloorRequest) (*response.BaseMessageResponse, *errs.Errs)
+	UpdateFloor(dc contexts.BearerContext, req request.UpdateFloorRequest) (*response.BaseMessageResponse, *errs.Errs)
+	SetFloorStatus(dc contexts.BearerContext, req request.SetFloorStatusRequest) (*response.BaseMessageResponse, *errs.Errs)
+	DeleteFloor(dc contexts.BearerContext, req *request.DeleteFloorRequest) (*response.BaseMessageResponse, *errs.Errs)
+	GetFloors(dc contexts.BearerContext, req *request.GetFloorRequest) (*response.GetFloorsResponse, *errs.Errs)
+	GetDetailFloor(dc contexts.BearerContext, req *request.GetDetailFloorRequest) (*response.GetDetailFloorResponse, *errs.Errs)
+	GetFloorOccupancy(dc contexts.BearerContext) (*response.GetFloorOccupancyResponse, *errs.Errs)
+	RefreshOccupancyMetrics() *errs.Errs
+	CreateZone(dc contexts.BearerContext, req request.CreateZoneRequest) (*response.BaseMessageResponse, *errs.Errs)
+	UpdateZone(dc contexts.BearerContext, req request.UpdateZoneRequest) (*response.BaseMessageResponse, *errs.Errs)
+	DeleteZone(dc contexts.BearerContext, req *request.DeleteZoneRequest) (*response.BaseMessageResponse, *errs.Errs)
+	GetZones(dc contexts.BearerContext, req *request.GetZoneRequest) (*response.GetZonesResponse, *errs.Errs)
+	GetDetailZone(dc contexts.BearerContext, req *request.GetDetailZoneRequest) (*response.GetDetailZoneResponse, *errs.Errs)
+}
+
+type usecaseObj struct {
+	FileSystem file.IFileSystem
+	Audit      audit.Trail
+}
+
+func NewFloorUsecase(ctx ...interface{}) IUsecaseFloor {
+	handle := usecaseObj{}
+	for _, c := range ctx {
+		switch c.(type) {
+		case file.IFileSystem:
+			handle.FileSystem = c.(file.IFileSystem)
+		case audit.Trail:
+			handle.Audit = c.(audit.Trail)
+		}
+	}
+	return &handle
+}
+
+// findFloor return index of active floor `id` on a site, -1 when not found.
+func findFloor(floors []models.Floor, siteId, id int) int {
+	for i, fl := range floors {
+		if fl.Id == id && fl.SiteId == siteId && fl.DeletedAt == nil {
+			return i
+		}
+	}
+	return -1
+}
+
+// findZone return index of active zone `id` on a site, -1 when not found.
+func findZone(zones []models.Zone, siteId, id int) int {
+	for i, zn := range zones {
+		if zn.Id == id && zn.SiteId == siteId && zn.DeletedAt == nil {
+			return i
+		}
+	}
+	return -1
+}
+
+// traced copy of usecase with storage traced under span `name`, span ended by returned func.
+func (ctx *usecaseObj) traced(dc contexts.BearerContext, name string) (*usecaseObj, func()) {
+	spanCtx, end := dc.StartSpan("UsecaseFloor." + name)
+	traced := *ctx
+	traced.FileSystem = file.WithTracing(ctx.FileSystem, spanCtx)
+	return &traced, end
+}
@@ -0,0 +1,77 @@
+package usecaseHealth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+// CheckReadiness check storage writable, every table loadable and database reachable when configured.
+// Ready only when every check up.
+func (ctx *usecaseObj) CheckReadiness(dc contexts.BearerContext) *response.ReadinessResponse {
+	ctx, end := ctx.traced(dc, "CheckReadiness")
+	defer end()
+
+	checks := []response.ReadinessCheckResponse{
+		readinessCheck("storage", ctx.checkStorageWritable()),
+		readinessCheck("tables", ctx.checkTablesLoad()),
+	}
+	if ctx.DB != nil {
+		checks = append(checks, readinessCheck("database", ctx.DB.Ping()))
+	}
+
+	status := constant.HealthUp
+	for _, c := range checks {
+		if c.Status != constant.HealthUp {
+			status = constant.HealthDown
+		}
+	}
+	return &response.ReadinessResponse{Status: status, Checks: checks}
+}
+
+func readinessCheck(name string, err error) response.ReadinessCheckResponse {
+	if err != nil {
+		return response.ReadinessCheckResponse{Name: name, Status: constant.HealthDown, Error: err.Error()}
+	}
+	return response.ReadinessCheckResponse{Name: name, Status: constant.HealthUp}
+}
+
+// checkStorageWritable write then remove probe file on storage directory.
+func (ctx *usecaseObj) checkStorageWritable() error {
+	if err := os.MkdirAll(ctx.StoragePath, os.ModePerm); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(ctx.StoragePath, ".readyz-*")
+	if err != nil {
+		return err
+	}
+	name := f.Name()
+	errClose := f.Close()
+	if errRemove := os.Remove(name); errRemove != nil {
+		return errRemove
+	}
+	return errClose
+}
+
+// checkTablesLoad read every json table on storage, table missing yet created on first use so not checked.
+func (ctx *usecaseObj) checkTablesLoad() error {
+	tables, err := filepath.Glob(filepath.Join(ctx.StoragePath, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		buff, err := os.ReadFile(table)
+		if err != nil {
+			return err
+		}
+		if len(buff) > 0 && !json.Valid(buff) {
+			return fmt.Errorf("table %s is not valid json", filepath.Base(table))
+		}
+	}
+	return nil
+}
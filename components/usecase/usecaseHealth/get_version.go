@@ -0,0 +1,22 @@
+package usecaseHealth
+
+import (
+	"runtime"
+
+	"github.com/mhaikalla/parking-service-management-library/build"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/condutils"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+// GetVersion build metadata set through `-ldflags -X` on `build` package, version `dev` when not set.
+func (ctx *usecaseObj) GetVersion(dc contexts.BearerContext) *response.VersionResponse {
+	return &response.VersionResponse{
+		Version:   condutils.Or(build.Version, "dev").(string),
+		BuildTime: build.Time,
+		BuildUser: build.User,
+		Branch:    build.Branch,
+		CommitSHA: build.CommitSHA,
+		GoVersion: runtime.Version(),
+	}
+}
@@ -0,0 +1,40 @@
+package usecaseHealth
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	database "github.com/mhaikalla/parking-service-management-library/pkg/database"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+type IUsecaseHealth interface {
+	CheckReadiness(dc contexts.BearerContext) *response.ReadinessResponse
+	GetVersion(dc contexts.BearerContext) *response.VersionResponse
+}
+
+// StoragePath directory json tables stored on.
+type StoragePath string
+
+type usecaseObj struct {
+	StoragePath string
+	DB          *database.DBChecker
+}
+
+func NewHealthUsecase(ctx ...interface{}) IUsecaseHealth {
+	handle := usecaseObj{}
+	for _, c := range ctx {
+		switch c.(type) {
+		case StoragePath:
+			handle.StoragePath = string(c.(StoragePath))
+		case *database.DBChecker:
+			handle.DB = c.(*database.DBChecker)
+		}
+	}
+	return &handle
+}
+
+// traced usecase under span `name`, span ended by returned func.
+func (ctx *usecaseObj) traced(dc contexts.BearerContext, name string) (*usecaseObj, func()) {
+	_, end := dc.StartSpan("UsecaseHealth." + name)
+	return ctx, end
+}
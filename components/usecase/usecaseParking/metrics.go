@@ -0,0 +1,16 @@
+package UsecaseParking
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/pkg/metrics"
+)
+
+var (
+	parkingInTotal = metrics.Default.NewCounter("parking_in_total",
+		"Vehicles parked by site and vehicle type.", "site_id", "vehicle_type")
+	parkingQueuedTotal = metrics.Default.NewCounter("parking_queued_total",
+		"Vehicles added to queue because no parking lot available, by site and vehicle type.", "site_id", "vehicle_type")
+	parkingOutTotal = metrics.Default.NewCounter("parking_out_total",
+		"Vehicles left parking by site and vehicle type.", "site_id", "vehicle_type")
+	parkingRevenueTotal = metrics.Default.NewCounter("parking_revenue_total",
+		"Parking fee charged by site, vehicle type and currency.", "site_id", "vehicle_type", "currency")
+)
@@ -1,9 +1,14 @@
 package UsecaseParking
 
 import (
+	"sync/atomic"
+	"time"
+
 	"github.com/mhaikalla/parking-service-management-library/components/models/request"
 	"github.com/mhaikalla/parking-service-management-library/components/models/response"
 
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
 	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
 	"github.com/mhaikalla/parking-service-management-library/pkg/file"
@@ -14,8 +19,64 @@ type IUsecaseParking interface {
 	SetParkingOut(dc contexts.BearerContext, req *request.ParkingOutRequest) (*response.ParkingOutResponse, *errs.Errs)
 	GetParkingData(dc contexts.BearerContext, req *request.GetParkingData) (*response.GetDataParkingResponse, *errs.Errs)
 	GetCountParkingData(dc contexts.BearerContext, req *request.GetCountParkingData) (*response.GetCountParkingResponse, *errs.Errs)
+	GetParkingQueue(dc contexts.BearerContext) (*response.GetParkingQueueResponse, *errs.Errs)
+	CancelParkingQueue(dc contexts.BearerContext, req *request.CancelParkingQueueRequest) (*response.BaseMessageResponse, *errs.Errs)
+	GetParkingSessions(dc contexts.BearerContext, req *request.GetParkingSessionRequest) (*response.GetParkingSessionsResponse, *errs.Errs)
+}
+
+// QueueConfig configure waiting queue used when parking area is full.
+// Offer held longer than HoldTimeout expired lazily, by next parking in/out or queue request of its site,
+// so every caller see it expired while no background sweep needed.
+type QueueConfig struct {
+	Enable      bool
+	HoldTimeout time.Duration
 }
 
 type usecaseObj struct {
 	FileSystem file.IFileSystem
+	Audit      audit.Trail
+	Queue      QueueConfig
+	Settings   *Settings
+}
+
+// Settings queue of usecase, swapped while serving when config reloaded.
+// Request keep settings it started with.
+type Settings struct {
+	value atomic.Value // QueueConfig
+}
+
+// NewSettings settings holding `queue`.
+func NewSettings(queue QueueConfig) *Settings {
+	s := &Settings{}
+	s.Set(queue)
+	return s
+}
+
+// Set replace queue, applied from next request.
+func (s *Settings) Set(queue QueueConfig) {
+	s.value.Store(queue)
+}
+
+// Queue current queue config.
+func (s *Settings) Queue() QueueConfig {
+	return s.value.Load().(QueueConfig)
+}
+
+// NewQueueConfig queue configured by `parking.queue` entry.
+func NewQueueConfig(conf config.ParkingQueueConfig) QueueConfig {
+	return QueueConfig{
+		Enable:      conf.Enable,
+		HoldTimeout: time.Duration(conf.HoldTimeout) * time.Second,
+	}
+}
+
+// traced copy of usecase with storage traced under span `name` and current settings, span ended by returned func.
+func (ctx *usecaseObj) traced(dc contexts.BearerContext, name string) (*usecaseObj, func()) {
+	spanCtx, end := dc.StartSpan("UsecaseParking." + name)
+	traced := *ctx
+	traced.FileSystem = file.WithTracing(ctx.FileSystem, spanCtx)
+	if ctx.Settings != nil {
+		traced.Queue = ctx.Settings.Queue()
+	}
+	return &traced, end
 }
@@ -11,6 +11,7 @@ import (
 	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
 	"github.com/mhaikalla/parking-service-management-library/components/models/request"
 	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
 	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
 	"github.com/mhaikalla/parking-service-management-library/pkg/file"
@@ -23,12 +24,22 @@ func NewParkingUsecase(ctx ...interface{}) IUsecaseParking {
 		switch c.(type) {
 		case file.IFileSystem:
 			handle.FileSystem = c.(file.IFileSystem)
+		case audit.Trail:
+			handle.Audit = c.(audit.Trail)
+		case QueueConfig:
+			handle.Queue = c.(QueueConfig)
+		case *Settings:
+			handle.Settings = c.(*Settings)
 		}
 	}
 	return &handle
 }
 
 func (ctx *usecaseObj) SetParkingIn(dc contexts.BearerContext, req *request.ParkingInRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "SetParkingIn")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ParkingLotTableName, models.ParkingVehicleStatusTableName, models.ParkingQueueTableName)()
+
 	resp := response.BaseMessageResponse{
 		Message: "failed",
 		Data:    nil,
@@ -82,9 +93,13 @@ func (ctx *usecaseObj) SetParkingIn(dc contexts.BearerContext, req *request.Park
 	}
 
 	dateNow := time.Now().UTC()
+	siteId := dc.GetSiteID()
 
 	for i := len(parkingStatusData) - 1; i >= 0; i-- {
-		if parkingStatusData[i].PlateNumber == req.PlatNomor && parkingStatusData[i].Status == constant.ParkingIn {
+		if parkingStatusData[i].PlateNumber != req.PlatNomor || parkingStatusData[i].SiteId != siteId {
+			continue
+		}
+		if parkingStatusData[i].Status == constant.ParkingIn {
 			return nil, errs.NewErrContext().
 				SetCode(errs.BadRequest).
 				SetMessage("This vehicle has already been parked")
@@ -92,18 +107,61 @@ func (ctx *usecaseObj) SetParkingIn(dc contexts.BearerContext, req *request.Park
 		break
 	}
 
-	for _, pld := range parkingLotData {
-		if !pld.IsParked && pld.DeletedAt == nil {
-			pld.UpdatedAt = dateNow
-			pld.IsParked = true
-			currentParkingLotData = pld
-			break
+	avail, errAvail := ctx.loadLotAvailability(dateNow)
+	if errAvail != nil {
+		return nil, errAvail
+	}
+
+	parkingQueueData := []models.ParkingQueue{}
+	queueIdx := -1
+	if ctx.Queue.Enable {
+		if err := file.LoadTable(ctx.FileSystem, models.ParkingQueueTableName, &parkingQueueData); err != nil {
+			return nil, err
 		}
+		refreshParkingQueue(parkingQueueData, parkingLotData, avail, siteId, dateNow, ctx.Queue.HoldTimeout)
+		queueIdx = findActiveQueue(parkingQueueData, siteId, req.PlatNomor)
+	}
+
+	held := heldParkingLots(parkingQueueData, siteId)
+	// lower floor filled first, lots on closed floor or out of service never allocated
+	for _, i := range allocationOrder(parkingLotData, avail, siteId) {
+		pld := parkingLotData[i]
+		// parking lot held for vehicle in queue only given to that vehicle
+		if holder, ok := held[pld.Name]; ok && holder != queueIdx {
+			continue
+		}
+		if queueIdx >= 0 && parkingQueueData[queueIdx].Status == constant.QueueOffered &&
+			parkingQueueData[queueIdx].OfferedParkingLot != pld.Name {
+			continue
+		}
+		parkingLotData[i].Touch(dateNow)
+		parkingLotData[i].IsParked = true
+		currentParkingLotData = parkingLotData[i]
+		break
 	}
 	if reflect.ValueOf(currentParkingLotData).IsZero() {
-		return nil, errs.NewErrContext().
-			SetCode(errs.BadRequest).
-			SetMessage("There's No Parking Area Available")
+		if !ctx.Queue.Enable {
+			return nil, errs.NewErrContext().
+				SetCode(errs.BadRequest).
+				SetMessage("There's No Parking Area Available")
+		}
+
+		// vehicle already queued keep its place, only new entry recorded and counted
+		queued := queueIdx < 0
+		parkingQueueData, queueIdx = enqueueVehicle(parkingQueueData, siteId, req, dateNow)
+		stat, err := ctx.FileSystem.SaveData(models.ParkingQueueTableName, parkingQueueData)
+		if !stat && err != nil {
+			return nil, errs.NewErrContext().
+				SetCode(errs.InternalServerError).
+				SetMessage(err.Error())
+		}
+		if queued {
+			audit.Record(ctx.Audit, dc, constant.AuditCreate, models.ParkingQueueTableName, parkingQueueData[queueIdx].Id, nil, parkingQueueData[queueIdx])
+			parkingQueuedTotal.Inc(strconv.Itoa(siteId), req.Tipe)
+		}
+		resp.Message = "There's No Parking Area Available, Vehicle Added To Queue"
+		resp.Data = toParkingQueueResponse(parkingQueueData, queueIdx)
+		return &resp, nil
 	}
 
 	parkingStatusData = append(parkingStatusData, models.ParkingVehicleStatus{
@@ -113,6 +171,7 @@ func (ctx *usecaseObj) SetParkingIn(dc contexts.BearerContext, req *request.Park
 			UpdatedAt: dateNow,
 			DeletedAt: nil,
 		},
+		SiteId:         siteId,
 		PlateNumber:    req.PlatNomor,
 		Type:           req.Tipe,
 		Color:          req.Warna,
@@ -133,8 +192,23 @@ func (ctx *usecaseObj) SetParkingIn(dc contexts.BearerContext, req *request.Park
 	if !saveDataParkingLot && errparkinglot != nil {
 		return nil, errs.NewErrContext().
 			SetCode(errs.InternalServerError).
-			SetMessage(err.Error())
+			SetMessage(errparkinglot.Error())
 	}
+	if ctx.Queue.Enable {
+		if queueIdx >= 0 {
+			parkingQueueData[queueIdx].Status = constant.QueueParked
+			parkingQueueData[queueIdx].Touch(dateNow)
+		}
+		stat, errQueue := ctx.FileSystem.SaveData(models.ParkingQueueTableName, parkingQueueData)
+		if !stat && errQueue != nil {
+			return nil, errs.NewErrContext().
+				SetCode(errs.InternalServerError).
+				SetMessage(errQueue.Error())
+		}
+	}
+	parked := parkingStatusData[len(parkingStatusData)-1]
+	audit.Record(ctx.Audit, dc, constant.AuditParkingIn, models.ParkingVehicleStatusTableName, parked.Id, nil, parked)
+	parkingInTotal.Inc(strconv.Itoa(siteId), parked.Type)
 	resp.Message = "Success"
 	resp.Data = req
 
@@ -142,6 +216,10 @@ func (ctx *usecaseObj) SetParkingIn(dc contexts.BearerContext, req *request.Park
 }
 
 func (ctx *usecaseObj) SetParkingOut(dc contexts.BearerContext, req *request.ParkingOutRequest) (*response.ParkingOutResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "SetParkingOut")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ParkingLotTableName, models.ParkingVehicleStatusTableName, models.ParkingQueueTableName)()
+
 	resp := response.ParkingOutResponse{}
 	parkingStatusData := []models.ParkingVehicleStatus{}
 	vehicleData := []models.Vehicle{}
@@ -258,13 +336,15 @@ func (ctx *usecaseObj) SetParkingOut(dc contexts.BearerContext, req *request.Par
 	currentData := models.ParkingVehicleStatus{}
 	currentVehicleData := models.Vehicle{}
 	currentParkingLotData := models.ParkingLot{}
+	parkingLotIdx := -1
 
 	dateNow := time.Now().UTC()
+	siteId := dc.GetSiteID()
 
 	lengthData := len(parkingStatusData)
 	if lengthData > 0 {
 		for i := lengthData - 1; i >= 0; i-- {
-			if parkingStatusData[i].PlateNumber == req.PlatNomor {
+			if parkingStatusData[i].PlateNumber == req.PlatNomor && parkingStatusData[i].SiteId == siteId {
 				if parkingStatusData[i].Status == constant.ParkingOut {
 					return nil, errs.NewErrContext().
 						SetCode(errs.BadRequest).
@@ -274,7 +354,8 @@ func (ctx *usecaseObj) SetParkingOut(dc contexts.BearerContext, req *request.Par
 				break
 			}
 		}
-	} else {
+	}
+	if reflect.ValueOf(currentData).IsZero() {
 		return nil, errs.NewErrContext().
 			SetCode(errs.BadRequest).
 			SetMessage("There's No Vehicle Parking With These Plate Number")
@@ -282,7 +363,7 @@ func (ctx *usecaseObj) SetParkingOut(dc contexts.BearerContext, req *request.Par
 
 	if len(vehicleData) > 0 {
 		for _, vd := range vehicleData {
-			if vd.Type == currentData.Type {
+			if vd.Type == currentData.Type && vd.SiteId == siteId && vd.DeletedAt == nil {
 				currentVehicleData = vd
 			}
 		}
@@ -298,9 +379,10 @@ func (ctx *usecaseObj) SetParkingOut(dc contexts.BearerContext, req *request.Par
 	}
 
 	if len(parkingLotData) > 0 {
-		for _, pld := range parkingLotData {
-			if pld.Name == currentData.ParkingLot {
+		for i, pld := range parkingLotData {
+			if pld.Name == currentData.ParkingLot && pld.SiteId == siteId {
 				currentParkingLotData = pld
+				parkingLotIdx = i
 			}
 		}
 		if reflect.ValueOf(currentParkingLotData).IsZero() {
@@ -324,6 +406,7 @@ func (ctx *usecaseObj) SetParkingOut(dc contexts.BearerContext, req *request.Par
 			UpdatedAt: dateNow,
 			DeletedAt: nil,
 		},
+		SiteId:         siteId,
 		PlateNumber:    currentData.PlateNumber,
 		Type:           currentData.Type,
 		Color:          currentData.Color,
@@ -331,6 +414,7 @@ func (ctx *usecaseObj) SetParkingOut(dc contexts.BearerContext, req *request.Par
 		ParkingOutDate: &dateNow,
 		Status:         constant.ParkingOut,
 		Price:          totalPrice,
+		ParkingLot:     currentData.ParkingLot,
 	})
 	stat, err := ctx.FileSystem.SaveData(models.ParkingVehicleStatusTableName, parkingStatusData)
 	if !stat && err != nil {
@@ -339,6 +423,40 @@ func (ctx *usecaseObj) SetParkingOut(dc contexts.BearerContext, req *request.Par
 			SetMessage(err.Error())
 	}
 
+	parkingLotData[parkingLotIdx].IsParked = false
+	parkingLotData[parkingLotIdx].Touch(dateNow)
+	statParkingLot, errParkingLot := ctx.FileSystem.SaveData(models.ParkingLotTableName, parkingLotData)
+	if !statParkingLot && errParkingLot != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errParkingLot.Error())
+	}
+
+	// freed parking lot offered to the head of waiting queue
+	if ctx.Queue.Enable {
+		parkingQueueData := []models.ParkingQueue{}
+		if err := file.LoadTable(ctx.FileSystem, models.ParkingQueueTableName, &parkingQueueData); err != nil {
+			return nil, err
+		}
+		avail, errAvail := ctx.loadLotAvailability(dateNow)
+		if errAvail != nil {
+			return nil, errAvail
+		}
+		if refreshParkingQueue(parkingQueueData, parkingLotData, avail, siteId, dateNow, ctx.Queue.HoldTimeout) {
+			statQueue, errQueue := ctx.FileSystem.SaveData(models.ParkingQueueTableName, parkingQueueData)
+			if !statQueue && errQueue != nil {
+				return nil, errs.NewErrContext().
+					SetCode(errs.InternalServerError).
+					SetMessage(errQueue.Error())
+			}
+		}
+	}
+
+	left := parkingStatusData[len(parkingStatusData)-1]
+	audit.Record(ctx.Audit, dc, constant.AuditParkingOut, models.ParkingVehicleStatusTableName, left.Id, currentData, left)
+	parkingOutTotal.Inc(strconv.Itoa(left.SiteId), left.Type)
+	parkingRevenueTotal.Add(float64(totalPrice), strconv.Itoa(left.SiteId), left.Type, constant.Currency)
+
 	resp.JumlahBayar = strconv.Itoa(totalPrice)
 	resp.PlatNomor = req.PlatNomor
 	resp.TanggalKeluar = dateNow
@@ -347,6 +465,9 @@ func (ctx *usecaseObj) SetParkingOut(dc contexts.BearerContext, req *request.Par
 }
 
 func (ctx *usecaseObj) GetParkingData(dc contexts.BearerContext, req *request.GetParkingData) (*response.GetDataParkingResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetParkingData")
+	defer end()
+
 	resultData := response.GetDataParkingResponse{}
 	parkingStatusData := []models.ParkingVehicleStatus{}
 
@@ -372,8 +493,9 @@ func (ctx *usecaseObj) GetParkingData(dc contexts.BearerContext, req *request.Ge
 		}
 	}
 
+	siteId := dc.GetSiteID()
 	for _, p := range parkingStatusData {
-		if p.DeletedAt == nil && p.Color == req.Warna {
+		if p.DeletedAt == nil && p.SiteId == siteId && p.Color == req.Warna {
 			resultData.PlatNomor = append(resultData.PlatNomor, p.PlateNumber)
 		}
 	}
@@ -384,6 +506,8 @@ func (ctx *usecaseObj) GetParkingData(dc contexts.BearerContext, req *request.Ge
 }
 
 func (ctx *usecaseObj) GetCountParkingData(dc contexts.BearerContext, req *request.GetCountParkingData) (*response.GetCountParkingResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetCountParkingData")
+	defer end()
 
 	resultData := response.GetCountParkingResponse{}
 
@@ -411,8 +535,9 @@ func (ctx *usecaseObj) GetCountParkingData(dc contexts.BearerContext, req *reque
 		}
 	}
 
+	siteId := dc.GetSiteID()
 	for _, p := range parkingStatusData {
-		if p.DeletedAt == nil && p.Type == req.Tipe {
+		if p.DeletedAt == nil && p.SiteId == siteId && p.Type == req.Tipe {
 			totalCount += 1
 		}
 	}
@@ -0,0 +1,74 @@
+package UsecaseParking
+
+import (
+	"sort"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// lotAvailability hold data deciding whether a parking lot can be allocated at `now`.
+type lotAvailability struct {
+	floors  []models.Floor
+	windows []models.MaintenanceWindow
+	now     time.Time
+}
+
+func (ctx *usecaseObj) loadLotAvailability(now time.Time) (*lotAvailability, *errs.Errs) {
+	avail := lotAvailability{
+		floors:  []models.Floor{},
+		windows: []models.MaintenanceWindow{},
+		now:     now,
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.FloorTableName, &avail.floors); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.MaintenanceWindowTableName, &avail.windows); err != nil {
+		return nil, err
+	}
+	return &avail, nil
+}
+
+// allocationOrder return index of available parking lots of a site ordered by floor level then by lot order.
+// Occupied lots, lots out of service or under maintenance window and lots on closed or deleted floor
+// are left out, lots without floor come last.
+func allocationOrder(parkingLots []models.ParkingLot, avail *lotAvailability, siteId int) []int {
+	levels := map[int]int{}
+	closed := map[int]bool{}
+	maxLevel := 0
+	for _, fl := range avail.floors {
+		if fl.SiteId != siteId {
+			continue
+		}
+		levels[fl.Id] = fl.Level
+		closed[fl.Id] = fl.IsClosed || fl.DeletedAt != nil
+		if fl.Level > maxLevel {
+			maxLevel = fl.Level
+		}
+	}
+
+	order := []int{}
+	for i, pl := range parkingLots {
+		if pl.DeletedAt != nil || pl.SiteId != siteId || closed[pl.FloorId] {
+			continue
+		}
+		if pl.CurrentStatus(avail.windows, avail.now) != constant.LotAvailable {
+			continue
+		}
+		order = append(order, i)
+	}
+
+	levelOf := func(pl models.ParkingLot) int {
+		if level, ok := levels[pl.FloorId]; ok {
+			return level
+		}
+		return maxLevel + 1
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return levelOf(parkingLots[order[i]]) < levelOf(parkingLots[order[j]])
+	})
+	return order
+}
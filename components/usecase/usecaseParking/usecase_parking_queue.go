@@ -0,0 +1,228 @@
+package UsecaseParking
+
+import (
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) GetParkingQueue(dc contexts.BearerContext) (*response.GetParkingQueueResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetParkingQueue")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ParkingLotTableName, models.ParkingVehicleStatusTableName, models.ParkingQueueTableName)()
+
+	resp := response.GetParkingQueueResponse{
+		Data: []response.ParkingQueueResponse{},
+	}
+	parkingQueueData := []models.ParkingQueue{}
+	parkingLotData := []models.ParkingLot{}
+
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingQueueTableName, &parkingQueueData); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingLotTableName, &parkingLotData); err != nil {
+		return nil, err
+	}
+	dateNow := time.Now().UTC()
+	avail, errAvail := ctx.loadLotAvailability(dateNow)
+	if errAvail != nil {
+		return nil, errAvail
+	}
+
+	siteId := dc.GetSiteID()
+	if refreshParkingQueue(parkingQueueData, parkingLotData, avail, siteId, dateNow, ctx.Queue.HoldTimeout) {
+		stat, err := ctx.FileSystem.SaveData(models.ParkingQueueTableName, parkingQueueData)
+		if !stat && err != nil {
+			return nil, errs.NewErrContext().
+				SetCode(errs.InternalServerError).
+				SetMessage(err.Error())
+		}
+	}
+
+	for i, pq := range parkingQueueData {
+		if pq.SiteId != siteId || !isQueueActive(pq) {
+			continue
+		}
+		resp.Data = append(resp.Data, toParkingQueueResponse(parkingQueueData, i))
+	}
+
+	return &resp, nil
+}
+
+func (ctx *usecaseObj) CancelParkingQueue(dc contexts.BearerContext, req *request.CancelParkingQueueRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "CancelParkingQueue")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ParkingLotTableName, models.ParkingVehicleStatusTableName, models.ParkingQueueTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	parkingQueueData := []models.ParkingQueue{}
+	parkingLotData := []models.ParkingLot{}
+
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingQueueTableName, &parkingQueueData); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingLotTableName, &parkingLotData); err != nil {
+		return nil, err
+	}
+	dateNow := time.Now().UTC()
+	avail, errAvail := ctx.loadLotAvailability(dateNow)
+	if errAvail != nil {
+		return nil, errAvail
+	}
+
+	siteId := dc.GetSiteID()
+	refreshParkingQueue(parkingQueueData, parkingLotData, avail, siteId, dateNow, ctx.Queue.HoldTimeout)
+
+	idx := findActiveQueue(parkingQueueData, siteId, req.PlatNomor)
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Queue Data Not Found")
+	}
+	before := parkingQueueData[idx]
+	parkingQueueData[idx].Status = constant.QueueCancelled
+	parkingQueueData[idx].Touch(dateNow)
+
+	// a cancelled offer release its parking lot to the next vehicle in queue
+	refreshParkingQueue(parkingQueueData, parkingLotData, avail, siteId, dateNow, ctx.Queue.HoldTimeout)
+
+	stat, err := ctx.FileSystem.SaveData(models.ParkingQueueTableName, parkingQueueData)
+	if !stat && err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+
+	audit.Record(ctx.Audit, dc, constant.AuditUpdate, models.ParkingQueueTableName, before.Id, before, parkingQueueData[idx])
+	resp.Message = "Success"
+	resp.Data = nil
+	return &resp, nil
+}
+
+// enqueueVehicle add vehicle to the tail of queue, when vehicle already queued return its index instead.
+func enqueueVehicle(queue []models.ParkingQueue, siteId int, req *request.ParkingInRequest, dateNow time.Time) ([]models.ParkingQueue, int) {
+	if idx := findActiveQueue(queue, siteId, req.PlatNomor); idx >= 0 {
+		return queue, idx
+	}
+	queue = append(queue, models.ParkingQueue{
+		BaseEntity: models.BaseEntity{
+			Id:        len(queue) + 1,
+			CreatedAt: dateNow,
+			UpdatedAt: dateNow,
+			DeletedAt: nil,
+		},
+		SiteId:      siteId,
+		PlateNumber: req.PlatNomor,
+		Type:        req.Tipe,
+		Color:       req.Warna,
+		Status:      constant.QueueWaiting,
+	})
+	return queue, len(queue) - 1
+}
+
+// refreshParkingQueue expire overdue offers then offer every free parking lot of a site to the head of its queue,
+// following floor allocation order.
+// Return true when queue data changed.
+func refreshParkingQueue(queue []models.ParkingQueue, parkingLots []models.ParkingLot, avail *lotAvailability, siteId int, dateNow time.Time, holdTimeout time.Duration) bool {
+	changed := false
+	for i := range queue {
+		if queue[i].Status == constant.QueueOffered && queue[i].OfferExpiredAt != nil && dateNow.After(*queue[i].OfferExpiredAt) {
+			queue[i].Status = constant.QueueExpired
+			queue[i].Touch(dateNow)
+			changed = true
+		}
+	}
+
+	held := heldParkingLots(queue, siteId)
+	for _, i := range allocationOrder(parkingLots, avail, siteId) {
+		pl := parkingLots[i]
+		if _, ok := held[pl.Name]; ok {
+			continue
+		}
+		next := nextWaitingQueue(queue, siteId)
+		if next < 0 {
+			break
+		}
+		expiredAt := dateNow.Add(holdTimeout)
+		queue[next].Status = constant.QueueOffered
+		queue[next].OfferedParkingLot = pl.Name
+		queue[next].OfferExpiredAt = &expiredAt
+		queue[next].Touch(dateNow)
+		held[pl.Name] = next
+		changed = true
+	}
+	return changed
+}
+
+// heldParkingLots return parking lot name of a site held by offered queue entries mapped to its queue index.
+func heldParkingLots(queue []models.ParkingQueue, siteId int) map[string]int {
+	held := map[string]int{}
+	for i, pq := range queue {
+		if pq.SiteId == siteId && pq.Status == constant.QueueOffered {
+			held[pq.OfferedParkingLot] = i
+		}
+	}
+	return held
+}
+
+// nextWaitingQueue return index of the oldest waiting entry of a site, -1 when queue is empty.
+func nextWaitingQueue(queue []models.ParkingQueue, siteId int) int {
+	for i, pq := range queue {
+		if pq.SiteId == siteId && pq.Status == constant.QueueWaiting {
+			return i
+		}
+	}
+	return -1
+}
+
+// findActiveQueue return index of waiting or offered entry for `plateNumber` on a site, -1 when not found.
+func findActiveQueue(queue []models.ParkingQueue, siteId int, plateNumber string) int {
+	for i, pq := range queue {
+		if pq.SiteId == siteId && pq.PlateNumber == plateNumber && isQueueActive(pq) {
+			return i
+		}
+	}
+	return -1
+}
+
+func isQueueActive(pq models.ParkingQueue) bool {
+	return pq.DeletedAt == nil && (pq.Status == constant.QueueWaiting || pq.Status == constant.QueueOffered)
+}
+
+// queuePosition return 1-based position of waiting entry, offered entry always on position 0.
+func queuePosition(queue []models.ParkingQueue, idx int) int {
+	if queue[idx].Status != constant.QueueWaiting {
+		return 0
+	}
+	position := 0
+	for i := 0; i <= idx; i++ {
+		if queue[i].SiteId == queue[idx].SiteId && queue[i].Status == constant.QueueWaiting {
+			position++
+		}
+	}
+	return position
+}
+
+func toParkingQueueResponse(queue []models.ParkingQueue, idx int) response.ParkingQueueResponse {
+	pq := queue[idx]
+	return response.ParkingQueueResponse{
+		Id:                pq.Id,
+		PlatNomor:         pq.PlateNumber,
+		Warna:             pq.Color,
+		Tipe:              pq.Type,
+		Status:            constant.QueueStatusName[pq.Status],
+		QueuePosition:     queuePosition(queue, idx),
+		OfferedParkingLot: pq.OfferedParkingLot,
+		OfferExpiredAt:    pq.OfferExpiredAt,
+		CreatedAt:         pq.CreatedAt,
+	}
+}
@@ -0,0 +1,128 @@
+package UsecaseParking
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ParkingQueueSuite struct {
+	suite.Suite
+	fs    file.IFileSystem
+	trail *audit.FileTrail
+	dc    contexts.BearerContext
+}
+
+func (s *ParkingQueueSuite) SetupTest() {
+	s.fs = file.NewFileSystem(s.T().TempDir() + "/")
+	s.trail = audit.NewFileTrail(s.fs)
+	dateNow := time.Now().UTC()
+	_, err := s.fs.SaveData(models.ParkingLotTableName, []models.ParkingLot{
+		{BaseEntity: models.BaseEntity{Id: 1, CreatedAt: dateNow, UpdatedAt: dateNow}, Name: "A1"},
+	})
+	s.Require().NoError(err)
+	_, err = s.fs.SaveData(models.VehicleTableName, []models.Vehicle{
+		{BaseEntity: models.BaseEntity{Id: 1, CreatedAt: dateNow, UpdatedAt: dateNow}, Name: "SUV", Type: "SUV", FirstHourPrice: 5000, PricePerHourPercent: 10},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *ParkingQueueSuite) usecase(holdTimeout time.Duration) IUsecaseParking {
+	return NewParkingUsecase(s.fs, s.trail, QueueConfig{Enable: true, HoldTimeout: holdTimeout})
+}
+
+func (s *ParkingQueueSuite) parkIn(u IUsecaseParking, plate string) *response.BaseMessageResponse {
+	resp, err := u.SetParkingIn(s.dc, &request.ParkingInRequest{PlatNomor: plate, Warna: "Hitam", Tipe: "SUV"})
+	s.Require().Nil(err, plate)
+	return resp
+}
+
+func (s *ParkingQueueSuite) queue(u IUsecaseParking) map[string]response.ParkingQueueResponse {
+	resp, err := u.GetParkingQueue(s.dc)
+	s.Require().Nil(err)
+	queue := map[string]response.ParkingQueueResponse{}
+	for _, pq := range resp.Data {
+		queue[pq.PlatNomor] = pq
+	}
+	return queue
+}
+
+func (s *ParkingQueueSuite) queueCreated() int {
+	_, total, err := s.trail.Query(audit.Filter{Action: constant.AuditCreate, Entity: models.ParkingQueueTableName})
+	s.Require().NoError(err)
+	return total
+}
+
+func (s *ParkingQueueSuite) TestFirstInFirstOffered() {
+	u := s.usecase(time.Minute)
+	s.parkIn(u, "B-1")
+	s.parkIn(u, "B-2")
+	s.parkIn(u, "B-3")
+
+	queue := s.queue(u)
+	s.Equal(1, queue["B-2"].QueuePosition)
+	s.Equal(2, queue["B-3"].QueuePosition)
+
+	_, err := u.SetParkingOut(s.dc, &request.ParkingOutRequest{PlatNomor: "B-1"})
+	s.Require().Nil(err)
+	queue = s.queue(u)
+	s.Equal(constant.QueueStatusName[constant.QueueOffered], queue["B-2"].Status)
+	s.Equal("A1", queue["B-2"].OfferedParkingLot)
+	s.Equal(1, queue["B-3"].QueuePosition)
+
+	// lot held for head of queue
+	resp := s.parkIn(u, "B-3")
+	s.IsType(response.ParkingQueueResponse{}, resp.Data)
+	resp = s.parkIn(u, "B-2")
+	s.Equal("Success", resp.Message)
+	s.NotContains(s.queue(u), "B-2")
+}
+
+func (s *ParkingQueueSuite) TestRequeueCountedOnce() {
+	u := s.usecase(time.Minute)
+	s.parkIn(u, "B-1")
+	queued := parkingQueuedTotal.Value(strconv.Itoa(s.dc.GetSiteID()), "SUV")
+
+	first := s.parkIn(u, "B-2").Data.(response.ParkingQueueResponse)
+	again := s.parkIn(u, "B-2").Data.(response.ParkingQueueResponse)
+	s.Equal(first.Id, again.Id)
+	s.Equal(1, again.QueuePosition)
+	s.Len(s.queue(u), 1)
+	s.Equal(1, s.queueCreated(), "re-queue must not be audited again")
+	s.Equal(queued+1, parkingQueuedTotal.Value(strconv.Itoa(s.dc.GetSiteID()), "SUV"), "re-queue must not be counted again")
+}
+
+func (s *ParkingQueueSuite) TestOfferExpired() {
+	u := s.usecase(200 * time.Millisecond)
+	s.parkIn(u, "B-1")
+	s.parkIn(u, "B-2")
+	s.parkIn(u, "B-3")
+	_, err := u.SetParkingOut(s.dc, &request.ParkingOutRequest{PlatNomor: "B-1"})
+	s.Require().Nil(err)
+	s.Equal("A1", s.queue(u)["B-2"].OfferedParkingLot)
+
+	time.Sleep(300 * time.Millisecond)
+	queue := s.queue(u)
+	s.NotContains(queue, "B-2", "expired offer must leave the queue")
+	s.Equal("A1", queue["B-3"].OfferedParkingLot, "lot must be offered to next in queue")
+
+	// vehicle missing its offer queued again at the tail as new entry
+	requeued := s.parkIn(u, "B-2").Data.(response.ParkingQueueResponse)
+	s.Equal(constant.QueueStatusName[constant.QueueWaiting], requeued.Status)
+	s.Equal(1, requeued.QueuePosition)
+	s.Equal(3, s.queueCreated())
+}
+
+func TestParkingQueueSuite(t *testing.T) {
+	suite.Run(t, new(ParkingQueueSuite))
+}
@@ -0,0 +1,65 @@
+package UsecaseParking
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// GetParkingSessions list parking sessions of site, vehicles still parked only unless `All` requested.
+// Session opened by parking in record and closed by later parking out record of same plate.
+func (ctx *usecaseObj) GetParkingSessions(dc contexts.BearerContext, req *request.GetParkingSessionRequest) (*response.GetParkingSessionsResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetParkingSessions")
+	defer end()
+
+	parkingStatusData := []models.ParkingVehicleStatus{}
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingVehicleStatusTableName, &parkingStatusData); err != nil {
+		return nil, err
+	}
+
+	sessions := []response.ParkingSessionResponse{}
+	open := map[string]int{}
+	siteId := dc.GetSiteID()
+	for _, p := range parkingStatusData {
+		if p.DeletedAt != nil || p.SiteId != siteId {
+			continue
+		}
+		if req.PlatNomor != "" && p.PlateNumber != req.PlatNomor {
+			continue
+		}
+		switch p.Status {
+		case constant.ParkingIn:
+			open[p.PlateNumber] = len(sessions)
+			sessions = append(sessions, response.ParkingSessionResponse{
+				Id:           p.Id,
+				PlatNomor:    p.PlateNumber,
+				Warna:        p.Color,
+				Tipe:         p.Type,
+				ParkingLot:   p.ParkingLot,
+				Status:       "IN",
+				TanggalMasuk: p.ParkingInDate,
+			})
+		case constant.ParkingOut:
+			idx, ok := open[p.PlateNumber]
+			if !ok {
+				continue
+			}
+			delete(open, p.PlateNumber)
+			sessions[idx].Status = "OUT"
+			sessions[idx].JumlahBayar = p.Price
+			sessions[idx].TanggalKeluar = p.ParkingOutDate
+		}
+	}
+
+	resp := response.GetParkingSessionsResponse{Data: []response.ParkingSessionResponse{}}
+	for _, session := range sessions {
+		if req.All || session.Status == "IN" {
+			resp.Data = append(resp.Data, session)
+		}
+	}
+	return &resp, nil
+}
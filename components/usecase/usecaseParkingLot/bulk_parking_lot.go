@@ -0,0 +1,380 @@
+package usecaseParkingLot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// CreateBulkParkingLots create parking lots from explicit `items` or from `prefix` with number range `start`..`end`.
+// Every item validated first, nothing saved when one of them failed.
+func (ctx *usecaseObj) CreateBulkParkingLots(dc contexts.BearerContext, req request.CreateBulkParkingLotRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "CreateBulkParkingLots")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ParkingLotTableName, models.FloorTableName)()
+
+	items := req.Items
+	if len(items) == 0 {
+		if req.End < req.Start || req.Start == 0 {
+			return nil, errs.NewErrContext().
+				SetCode(errs.BadRequest).
+				SetMessage("Invalid Parking Lot Range")
+		}
+		if req.End-req.Start+1 > constant.BulkMaxItems {
+			return nil, errs.NewErrContext().
+				SetCode(errs.BadRequest).
+				SetMessage(fmt.Sprintf("Maximum %d Parking Lot Per Request", constant.BulkMaxItems))
+		}
+		if req.Floor == "" && req.FloorId == 0 {
+			return nil, errs.NewErrContext().
+				SetCode(errs.BadRequest).
+				SetMessage("Floor Is Required")
+		}
+		for n := req.Start; n <= req.End; n++ {
+			items = append(items, request.CreateParkingLotRequest{
+				Name:    fmt.Sprintf("%s%0*d", req.Prefix, req.Pad, n),
+				Floor:   req.Floor,
+				FloorId: req.FloorId,
+				ZoneId:  req.ZoneId,
+			})
+		}
+	}
+	if len(items) > constant.BulkMaxItems {
+		return nil, errs.NewErrContext().
+			SetCode(errs.BadRequest).
+			SetMessage(fmt.Sprintf("Maximum %d Parking Lot Per Request", constant.BulkMaxItems))
+	}
+
+	parkingLotData := []models.ParkingLot{}
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingLotTableName, &parkingLotData); err != nil {
+		return nil, err
+	}
+	siteId := dc.GetSiteID()
+	resolver, err := ctx.newFloorResolver(siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	dateNow := time.Now().UTC()
+	result := response.BulkParkingLotResponse{Total: len(items)}
+	lotIdx := map[int]int{}
+	for i, item := range items {
+		itemResult := response.BulkParkingLotItemResponse{
+			Index:   i,
+			Name:    item.Name,
+			Floor:   item.Floor,
+			FloorId: item.FloorId,
+			ZoneId:  item.ZoneId,
+			Status:  constant.BulkCreated,
+		}
+		if itemResult.Message = validateBulkParkingLot(item.Name, item.Floor, item.FloorId); itemResult.Message != "" {
+			itemResult.Status = constant.BulkFailed
+			result.Items = append(result.Items, itemResult)
+			continue
+		}
+		floor, errFloor := resolver.resolve(item.FloorId, item.Floor, item.ZoneId)
+		if errFloor != nil {
+			itemResult.Status = constant.BulkFailed
+			itemResult.Message = errFloor.Message
+			result.Items = append(result.Items, itemResult)
+			continue
+		}
+
+		parkingLotData = append(parkingLotData, models.ParkingLot{
+			BaseEntity: models.BaseEntity{
+				Id:        len(parkingLotData) + 1,
+				CreatedAt: dateNow,
+				UpdatedAt: dateNow,
+				DeletedAt: nil,
+			},
+			SiteId:  siteId,
+			Floor:   floor.Name,
+			FloorId: floor.Id,
+			ZoneId:  item.ZoneId,
+			Name:    item.Name,
+		})
+		lotIdx[i] = len(parkingLotData) - 1
+		itemResult.ParkingLotId = len(parkingLotData)
+		itemResult.Floor = floor.Name
+		itemResult.FloorId = floor.Id
+		result.Items = append(result.Items, itemResult)
+	}
+	checkBulkParkingLots(&result, parkingLotData, lotIdx, resolver)
+
+	resp, errSave := ctx.saveBulkParkingLots(&result, parkingLotData, "Success, Data Created")
+	if errSave != nil && resp != nil {
+		// parking lot id only given to created parking lot
+		for i := range result.Items {
+			result.Items[i].ParkingLotId = 0
+		}
+	}
+	if errSave == nil {
+		ctx.auditBulkParkingLots(dc, constant.AuditCreate, parkingLotData, lotIdx, nil)
+	}
+	return resp, errSave
+}
+
+// UpdateBulkParkingLots update name, floor and zone of many parking lots at once, nothing saved when one of them failed.
+func (ctx *usecaseObj) UpdateBulkParkingLots(dc contexts.BearerContext, req request.UpdateBulkParkingLotRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "UpdateBulkParkingLots")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ParkingLotTableName, models.FloorTableName)()
+
+	if len(req.Items) > constant.BulkMaxItems {
+		return nil, errs.NewErrContext().
+			SetCode(errs.BadRequest).
+			SetMessage(fmt.Sprintf("Maximum %d Parking Lot Per Request", constant.BulkMaxItems))
+	}
+
+	parkingLotData := []models.ParkingLot{}
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingLotTableName, &parkingLotData); err != nil {
+		return nil, err
+	}
+	siteId := dc.GetSiteID()
+	resolver, err := ctx.newFloorResolver(siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	dateNow := time.Now().UTC()
+	result := response.BulkParkingLotResponse{Total: len(req.Items)}
+	lotIdx := map[int]int{}
+	before := map[int]models.ParkingLot{}
+	seen := map[int]bool{}
+	for i, item := range req.Items {
+		itemResult := response.BulkParkingLotItemResponse{
+			Index:   i,
+			Name:    item.Name,
+			Floor:   item.Floor,
+			FloorId: item.FloorId,
+			ZoneId:  item.ZoneId,
+			Status:  constant.BulkUpdated,
+		}
+		idx := findParkingLot(parkingLotData, siteId, item.Id)
+		if idx >= 0 {
+			itemResult.ParkingLotId = parkingLotData[idx].Id
+		}
+		errItem := validateBulkParkingLot(item.Name, item.Floor, item.FloorId)
+		var floor *models.Floor
+		var errFloor *errs.Errs
+		if errItem == "" {
+			floor, errFloor = resolver.resolve(item.FloorId, item.Floor, item.ZoneId)
+		}
+		switch {
+		case errItem != "":
+			itemResult.Message = errItem
+		case idx < 0:
+			itemResult.Message = "Data Not Found"
+		case seen[idx]:
+			itemResult.Message = "Duplicate Parking Lot In Request"
+		case !parkingLotData[idx].MatchVersion(item.Version):
+			itemResult.Message = "Data Has Been Changed, Reload And Retry"
+		case parkingLotData[idx].IsParked:
+			itemResult.Message = "This Parking Area Has Filled"
+		case errFloor != nil:
+			itemResult.Message = errFloor.Message
+		}
+		if itemResult.Message != "" {
+			itemResult.Status = constant.BulkFailed
+			result.Items = append(result.Items, itemResult)
+			continue
+		}
+		seen[idx] = true
+
+		before[idx] = parkingLotData[idx]
+		parkingLotData[idx].Touch(dateNow)
+		parkingLotData[idx].Name = item.Name
+		parkingLotData[idx].Floor = floor.Name
+		parkingLotData[idx].FloorId = floor.Id
+		parkingLotData[idx].ZoneId = item.ZoneId
+		lotIdx[i] = idx
+		itemResult.Floor = floor.Name
+		itemResult.FloorId = floor.Id
+		result.Items = append(result.Items, itemResult)
+	}
+	checkBulkParkingLots(&result, parkingLotData, lotIdx, resolver)
+
+	resp, errSave := ctx.saveBulkParkingLots(&result, parkingLotData, "Success, Data Updated")
+	if errSave == nil {
+		ctx.auditBulkParkingLots(dc, constant.AuditUpdate, parkingLotData, lotIdx, before)
+	}
+	return resp, errSave
+}
+
+// DeleteBulkParkingLots soft delete many parking lots at once, nothing deleted when one of them failed.
+func (ctx *usecaseObj) DeleteBulkParkingLots(dc contexts.BearerContext, req *request.DeleteBulkParkingLotRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "DeleteBulkParkingLots")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ParkingLotTableName, models.FloorTableName)()
+
+	if len(req.ParkingLotIds) > constant.BulkMaxItems {
+		return nil, errs.NewErrContext().
+			SetCode(errs.BadRequest).
+			SetMessage(fmt.Sprintf("Maximum %d Parking Lot Per Request", constant.BulkMaxItems))
+	}
+
+	parkingLotData := []models.ParkingLot{}
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingLotTableName, &parkingLotData); err != nil {
+		return nil, err
+	}
+	siteId := dc.GetSiteID()
+
+	dateNow := time.Now().UTC()
+	result := response.BulkParkingLotResponse{Total: len(req.ParkingLotIds)}
+	lotIdx := map[int]int{}
+	before := map[int]models.ParkingLot{}
+	seen := map[int]bool{}
+	for i, id := range req.ParkingLotIds {
+		itemResult := response.BulkParkingLotItemResponse{
+			Index:        i,
+			ParkingLotId: id,
+			Status:       constant.BulkDeleted,
+		}
+		idx := findParkingLot(parkingLotData, siteId, fmt.Sprint(id))
+		switch {
+		case seen[id]:
+			itemResult.Message = "Duplicate Parking Lot In Request"
+		case idx < 0:
+			itemResult.Message = "Data Not Found"
+		case parkingLotData[idx].IsParked:
+			itemResult.Message = "This Parking Area was Filled"
+		}
+		if idx >= 0 {
+			itemResult.Name = parkingLotData[idx].Name
+			itemResult.Floor = parkingLotData[idx].Floor
+			itemResult.FloorId = parkingLotData[idx].FloorId
+			itemResult.ZoneId = parkingLotData[idx].ZoneId
+		}
+		if itemResult.Message != "" {
+			itemResult.Status = constant.BulkFailed
+			result.Items = append(result.Items, itemResult)
+			continue
+		}
+		seen[id] = true
+		lotIdx[i] = idx
+		before[idx] = parkingLotData[idx]
+		parkingLotData[idx].MarkDeleted(dateNow)
+		result.Items = append(result.Items, itemResult)
+	}
+
+	resp, errSave := ctx.saveBulkParkingLots(&result, parkingLotData, "Success, Data Deleted")
+	if errSave == nil {
+		ctx.auditBulkParkingLots(dc, constant.AuditDelete, parkingLotData, lotIdx, before)
+	}
+	return resp, errSave
+}
+
+// auditBulkParkingLots record every parking lot saved by bulk request in item order.
+// `lotIdx` map item index to its parking lot index, `before` keyed by parking lot index, deleted parking lot
+// recorded without after state.
+func (ctx *usecaseObj) auditBulkParkingLots(dc contexts.BearerContext, action string, parkingLots []models.ParkingLot, lotIdx map[int]int, before map[int]models.ParkingLot) {
+	items := make([]int, 0, len(lotIdx))
+	for i := range lotIdx {
+		items = append(items, i)
+	}
+	sort.Ints(items)
+	for _, i := range items {
+		idx := lotIdx[i]
+		var prev, next interface{}
+		if pl, ok := before[idx]; ok {
+			prev = pl
+		}
+		if action != constant.AuditDelete {
+			next = parkingLots[idx]
+		}
+		audit.Record(ctx.Audit, dc, action, models.ParkingLotTableName, parkingLots[idx].Id, prev, next)
+	}
+}
+
+// checkBulkParkingLots fail items which name already used on the same floor or which floor over its capacity.
+// `lotIdx` map item index to its parking lot index.
+func checkBulkParkingLots(result *response.BulkParkingLotResponse, parkingLots []models.ParkingLot, lotIdx map[int]int, resolver *floorResolver) {
+	names := map[string]int{}
+	for _, pl := range parkingLots {
+		if pl.SiteId == resolver.siteId && pl.DeletedAt == nil {
+			names[fmt.Sprintf("%d/%s", pl.FloorId, strings.ToLower(pl.Name))]++
+		}
+	}
+	full := resolver.overCapacity(parkingLots)
+
+	for i, idx := range lotIdx {
+		pl := parkingLots[idx]
+		switch {
+		case names[fmt.Sprintf("%d/%s", pl.FloorId, strings.ToLower(pl.Name))] > 1:
+			result.Items[i].Message = "Parking Lot Name Already Exists On This Floor"
+		case full != nil && full.Id == pl.FloorId:
+			result.Items[i].Message = "Floor Capacity Has Been Reached"
+		default:
+			continue
+		}
+		result.Items[i].Status = constant.BulkFailed
+	}
+}
+
+// saveBulkParkingLots save all parking lots in a single write when every item succeed, otherwise mark
+// the other items as skipped and return the report along with the error.
+func (ctx *usecaseObj) saveBulkParkingLots(result *response.BulkParkingLotResponse, parkingLots []models.ParkingLot, message string) (*response.BaseMessageResponse, *errs.Errs) {
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+		Data:    result,
+	}
+	for _, item := range result.Items {
+		if item.Status == constant.BulkFailed {
+			result.Failed++
+		}
+	}
+	if result.Failed > 0 {
+		for i := range result.Items {
+			if result.Items[i].Status != constant.BulkFailed {
+				result.Items[i].Status = constant.BulkSkipped
+			}
+		}
+		resp.Message = "Failed, No Data Saved"
+		return &resp, errs.NewErrContext().
+			SetCode(errs.BadRequest).
+			SetMessage(resp.Message)
+	}
+
+	stat, err := ctx.FileSystem.SaveData(models.ParkingLotTableName, parkingLots)
+	if !stat && err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	result.Success = result.Total
+	resp.Message = message
+	return &resp, nil
+}
+
+// validateBulkParkingLot return reason an item of bulk request invalid, empty when valid.
+func validateBulkParkingLot(name, floor string, floorId int) string {
+	switch {
+	case name == "":
+		return "Name Is Required"
+	case floor == "" && floorId <= 0:
+		return "Floor Is Required"
+	case floorId < 0:
+		return "Invalid Floor Id"
+	}
+	return ""
+}
+
+// findParkingLot return index of active parking lot `id` on a site, -1 when not found.
+func findParkingLot(parkingLots []models.ParkingLot, siteId int, id string) int {
+	for i, pl := range parkingLots {
+		if fmt.Sprint(pl.Id) == id && pl.SiteId == siteId && pl.DeletedAt == nil {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,95 @@
+package usecaseParkingLot
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// CreateMaintenanceWindow schedule parking lots or a whole floor for maintenance, covered lots blocked
+// from allocation while window active and released automatically when it ends.
+func (ctx *usecaseObj) CreateMaintenanceWindow(dc contexts.BearerContext, req request.CreateMaintenanceWindowRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "CreateMaintenanceWindow")
+	defer end()
+	defer ctx.FileSystem.Lock(models.MaintenanceWindowTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	dateNow := time.Now().UTC()
+	if !req.EndAt.After(dateNow) {
+		return nil, errs.NewErrContext().
+			SetCode(errs.BadRequest).
+			SetMessage("Maintenance Window Already Ended")
+	}
+
+	windowData := []models.MaintenanceWindow{}
+	parkingLotData := []models.ParkingLot{}
+	floorData := []models.Floor{}
+	if err := file.LoadTable(ctx.FileSystem, models.MaintenanceWindowTableName, &windowData); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingLotTableName, &parkingLotData); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.FloorTableName, &floorData); err != nil {
+		return nil, err
+	}
+
+	siteId := dc.GetSiteID()
+	if req.FloorId != 0 {
+		floorFound := false
+		for _, fl := range floorData {
+			if fl.Id == req.FloorId && fl.SiteId == siteId && fl.DeletedAt == nil {
+				floorFound = true
+				break
+			}
+		}
+		if !floorFound {
+			return nil, errs.NewErrContext().
+				SetCode(errs.NotFound).
+				SetMessage("Floor Data Not Found")
+		}
+	}
+	for _, id := range req.ParkingLotIds {
+		if findParkingLot(parkingLotData, siteId, strconv.Itoa(id)) < 0 {
+			return nil, errs.NewErrContext().
+				SetCode(errs.NotFound).
+				SetMessage("Parking Lot " + strconv.Itoa(id) + " Not Found")
+		}
+	}
+
+	windowData = append(windowData, models.MaintenanceWindow{
+		BaseEntity: models.BaseEntity{
+			Id:        len(windowData) + 1,
+			CreatedAt: dateNow,
+			UpdatedAt: dateNow,
+			DeletedAt: nil,
+		},
+		SiteId:        siteId,
+		ParkingLotIds: req.ParkingLotIds,
+		FloorId:       req.FloorId,
+		StartAt:       req.StartAt.UTC(),
+		EndAt:         req.EndAt.UTC(),
+		Reason:        req.Reason,
+	})
+	stat, err := ctx.FileSystem.SaveData(models.MaintenanceWindowTableName, windowData)
+	if !stat && err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	created := windowData[len(windowData)-1]
+	audit.Record(ctx.Audit, dc, constant.AuditCreate, models.MaintenanceWindowTableName, created.Id, nil, created)
+	resp.Message = "Success"
+	resp.Data = toMaintenanceWindowResponse(created, dateNow)
+	return &resp, nil
+}
@@ -4,14 +4,20 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
 	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
 	"github.com/mhaikalla/parking-service-management-library/components/models/request"
 	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
 	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
 )
 
 func (ctx *usecaseObj) CreateParkingLot(dc contexts.BearerContext, req request.CreateParkingLotRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "CreateParkingLot")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ParkingLotTableName, models.FloorTableName)()
+
 	resp := response.BaseMessageResponse{
 		Message: "failed",
 	}
@@ -38,6 +44,11 @@ func (ctx *usecaseObj) CreateParkingLot(dc contexts.BearerContext, req request.C
 		}
 	}
 
+	floor, errFloor := ctx.resolveFloor(dc.GetSiteID(), req.FloorId, req.Floor, req.ZoneId, parkingLotData, 0)
+	if errFloor != nil {
+		return nil, errFloor
+	}
+
 	dateNow := time.Now().UTC()
 
 	parkingLotData = append(parkingLotData, models.ParkingLot{
@@ -47,8 +58,11 @@ func (ctx *usecaseObj) CreateParkingLot(dc contexts.BearerContext, req request.C
 			UpdatedAt: dateNow,
 			DeletedAt: nil,
 		},
-		Floor: req.Floor,
-		Name:  req.Name,
+		SiteId:  dc.GetSiteID(),
+		Floor:   floor.Name,
+		FloorId: floor.Id,
+		ZoneId:  req.ZoneId,
+		Name:    req.Name,
 	})
 	stat, err := ctx.FileSystem.SaveData(models.ParkingLotTableName, parkingLotData)
 	if !stat && err != nil {
@@ -56,6 +70,8 @@ func (ctx *usecaseObj) CreateParkingLot(dc contexts.BearerContext, req request.C
 			SetCode(errs.InternalServerError).
 			SetMessage(err.Error())
 	}
+	created := parkingLotData[len(parkingLotData)-1]
+	audit.Record(ctx.Audit, dc, constant.AuditCreate, models.ParkingLotTableName, created.Id, nil, created)
 	resp.Message = "Success"
 	resp.Data = req
 	return &resp, nil
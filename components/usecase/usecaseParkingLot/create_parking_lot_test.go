@@ -0,0 +1,59 @@
+package usecaseParkingLot
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/usecase/usecaseFloor"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateParkingLotSuite struct {
+	suite.Suite
+	usecase IUsecaseParkingLot
+	floor   usecaseFloor.IUsecaseFloor
+	dc      contexts.BearerContext
+}
+
+func (s *CreateParkingLotSuite) SetupTest() {
+	fs := file.NewFileSystem(s.T().TempDir() + "/")
+	s.usecase = NewParkingLotUsecase(fs)
+	s.floor = usecaseFloor.NewFloorUsecase(fs)
+	_, err := s.floor.CreateFloor(s.dc, request.CreateFloorRequest{Name: "Level 2", Level: 2})
+	s.Require().Nil(err)
+}
+
+func (s *CreateParkingLotSuite) TestKnownFloorName() {
+	_, err := s.usecase.CreateParkingLot(s.dc, request.CreateParkingLotRequest{Name: "A1", Floor: "level 2"})
+	s.Nil(err)
+	s.floorCount(1)
+}
+
+func (s *CreateParkingLotSuite) TestUnknownFloorName() {
+	_, err := s.usecase.CreateParkingLot(s.dc, request.CreateParkingLotRequest{Name: "A1", Floor: "Lvl 2"})
+	s.Require().NotNil(err)
+	s.Equal(strconv.Itoa(errs.NotFound), err.Code)
+	s.floorCount(1)
+}
+
+func (s *CreateParkingLotSuite) TestBulkUnknownFloorName() {
+	_, err := s.usecase.CreateBulkParkingLots(s.dc, request.CreateBulkParkingLotRequest{Floor: "Lvl 2", Start: 1, End: 3})
+	s.Require().NotNil(err)
+	s.Equal(strconv.Itoa(errs.BadRequest), err.Code)
+	s.floorCount(1)
+}
+
+func (s *CreateParkingLotSuite) floorCount(count int) {
+	floors, err := s.floor.GetFloors(s.dc, &request.GetFloorRequest{})
+	s.Require().Nil(err)
+	s.Len(floors.Data, count, "floor must not be created from parking lot request")
+}
+
+func TestCreateParkingLotSuite(t *testing.T) {
+	suite.Run(t, new(CreateParkingLotSuite))
+}
@@ -0,0 +1,58 @@
+package usecaseParkingLot
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// DeleteMaintenanceWindow cancel a maintenance window, covered parking lots available again right away.
+func (ctx *usecaseObj) DeleteMaintenanceWindow(dc contexts.BearerContext, req *request.DeleteMaintenanceWindowRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "DeleteMaintenanceWindow")
+	defer end()
+	defer ctx.FileSystem.Lock(models.MaintenanceWindowTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	windowData := []models.MaintenanceWindow{}
+	if err := file.LoadTable(ctx.FileSystem, models.MaintenanceWindowTableName, &windowData); err != nil {
+		return nil, err
+	}
+
+	id, errConv := strconv.Atoi(req.MaintenanceWindowId)
+	if errConv != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errConv.Error())
+	}
+
+	idx := findMaintenanceWindow(windowData, dc.GetSiteID(), id)
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Data Not Found")
+	}
+
+	before := windowData[idx]
+	windowData[idx].MarkDeleted(time.Now().UTC())
+
+	stat, err := ctx.FileSystem.SaveData(models.MaintenanceWindowTableName, windowData)
+	if !stat && err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditDelete, models.MaintenanceWindowTableName, before.Id, before, nil)
+	resp.Message = "Success"
+	resp.Data = nil
+	return &resp, nil
+}
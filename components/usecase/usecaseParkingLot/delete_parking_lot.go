@@ -5,20 +5,27 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
 	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
 	"github.com/mhaikalla/parking-service-management-library/components/models/request"
 	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
 	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
 )
 
 func (ctx *usecaseObj) DeleteParkingLots(dc contexts.BearerContext, req *request.DeleteParkingLotRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "DeleteParkingLots")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ParkingLotTableName)()
+
 	resp := response.BaseMessageResponse{
 		Message: "failed",
 	}
 	tableName := models.ParkingLotTableName
 	dateNow := time.Now().UTC()
 	parkingLotData := []models.ParkingLot{}
+	var before *models.ParkingLot
 	if !ctx.FileSystem.IsFileExisting(tableName) {
 		_, errCreate := ctx.FileSystem.CreateFile(tableName)
 		if errCreate != nil {
@@ -48,7 +55,7 @@ func (ctx *usecaseObj) DeleteParkingLots(dc contexts.BearerContext, req *request
 				SetMessage(errConv.Error())
 		}
 		for i, pl := range parkingLotData {
-			if pl.Id == id {
+			if pl.Id == id && pl.SiteId == dc.GetSiteID() {
 				if pl.DeletedAt != nil {
 					return nil, errs.NewErrContext().
 						SetCode(errs.NotFound).
@@ -59,7 +66,8 @@ func (ctx *usecaseObj) DeleteParkingLots(dc contexts.BearerContext, req *request
 						SetCode(errs.NotFound).
 						SetMessage("This Parking Area was Filled")
 				}
-				parkingLotData[i].DeletedAt = &dateNow
+				before = &pl
+				parkingLotData[i].MarkDeleted(dateNow)
 				break
 			}
 		}
@@ -70,6 +78,9 @@ func (ctx *usecaseObj) DeleteParkingLots(dc contexts.BearerContext, req *request
 			SetCode(errs.InternalServerError).
 			SetMessage(err.Error())
 	}
+	if before != nil {
+		audit.Record(ctx.Audit, dc, constant.AuditDelete, tableName, before.Id, before, nil)
+	}
 	resp.Message = "Success"
 	resp.Data = nil
 	return &resp, nil
@@ -0,0 +1,40 @@
+package usecaseParkingLot
+
+import (
+	"strconv"
+	"time"
+
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) GetDetailMaintenanceWindow(dc contexts.BearerContext, req *request.GetDetailMaintenanceWindowRequest) (*response.GetDetailMaintenanceWindowResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetDetailMaintenanceWindow")
+	defer end()
+
+	windowData := []models.MaintenanceWindow{}
+	if err := file.LoadTable(ctx.FileSystem, models.MaintenanceWindowTableName, &windowData); err != nil {
+		return nil, err
+	}
+
+	id, errConv := strconv.Atoi(req.MaintenanceWindowId)
+	if errConv != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errConv.Error())
+	}
+
+	idx := findMaintenanceWindow(windowData, dc.GetSiteID(), id)
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Data Not Found")
+	}
+
+	resp := toMaintenanceWindowResponse(windowData[idx], time.Now().UTC())
+	return &resp, nil
+}
@@ -4,15 +4,20 @@ import (
 	"encoding/json"
 	"reflect"
 	"strconv"
+	"time"
 
 	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
 	"github.com/mhaikalla/parking-service-management-library/components/models/request"
 	"github.com/mhaikalla/parking-service-management-library/components/models/response"
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
 	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
 )
 
 func (ctx *usecaseObj) GetDetailParkingLot(dc contexts.BearerContext, req *request.GetDetailParkingLotRequest) (*response.GetDetailParkingLotResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetDetailParkingLot")
+	defer end()
+
 	resp := response.GetDetailParkingLotResponse{}
 
 	parkingLotData := []models.ParkingLot{}
@@ -41,6 +46,12 @@ func (ctx *usecaseObj) GetDetailParkingLot(dc contexts.BearerContext, req *reque
 				SetMessage(err.Error())
 		}
 	}
+	windowData := []models.MaintenanceWindow{}
+	if err := file.LoadTable(ctx.FileSystem, models.MaintenanceWindowTableName, &windowData); err != nil {
+		return nil, err
+	}
+	dateNow := time.Now().UTC()
+
 	id, errConv := strconv.Atoi(req.ParkingLotId)
 	if errConv != nil {
 		return nil, errs.NewErrContext().
@@ -49,7 +60,7 @@ func (ctx *usecaseObj) GetDetailParkingLot(dc contexts.BearerContext, req *reque
 	}
 
 	for _, pld := range parkingLotData {
-		if pld.Id == id {
+		if pld.Id == id && pld.SiteId == dc.GetSiteID() {
 			if pld.DeletedAt != nil {
 				return nil, errs.NewErrContext().
 					SetCode(errs.NotFound).
@@ -65,16 +76,26 @@ func (ctx *usecaseObj) GetDetailParkingLot(dc contexts.BearerContext, req *reque
 			SetMessage("Data Not Found")
 	}
 
-	resp = response.GetDetailParkingLotResponse{
+	resp = toParkingLotResponse(resultData, windowData, dateNow)
+
+	return &resp, nil
+}
+
+func toParkingLotResponse(pl models.ParkingLot, windows []models.MaintenanceWindow, now time.Time) response.GetDetailParkingLotResponse {
+	return response.GetDetailParkingLotResponse{
 		BaseResponse: response.BaseResponse{
-			Id:        resultData.Id,
-			CreatedAt: resultData.CreatedAt,
-			UpdatedAt: resultData.UpdatedAt,
+			Id:        pl.Id,
+			CreatedAt: pl.CreatedAt,
+			UpdatedAt: pl.UpdatedAt,
+			Version:   pl.Version,
 		},
-		Name:     resultData.Name,
-		Floor:    resultData.Floor,
-		IsParked: resultData.IsParked,
+		SiteId:       pl.SiteId,
+		Name:         pl.Name,
+		Floor:        pl.Floor,
+		FloorId:      pl.FloorId,
+		ZoneId:       pl.ZoneId,
+		IsParked:     pl.IsParked,
+		Status:       pl.CurrentStatus(windows, now),
+		StatusReason: pl.StatusReason,
 	}
-
-	return &resp, nil
 }
@@ -0,0 +1,67 @@
+package usecaseParkingLot
+
+import (
+	"time"
+
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) GetMaintenanceWindows(dc contexts.BearerContext, req *request.GetMaintenanceWindowRequest) (*response.GetMaintenanceWindowsResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetMaintenanceWindows")
+	defer end()
+
+	resp := response.GetMaintenanceWindowsResponse{}
+	windowData := []models.MaintenanceWindow{}
+	resultData := []response.GetDetailMaintenanceWindowResponse{}
+
+	if err := file.LoadTable(ctx.FileSystem, models.MaintenanceWindowTableName, &windowData); err != nil {
+		return nil, err
+	}
+
+	dateNow := time.Now().UTC()
+	for _, mw := range windowData {
+		if mw.DeletedAt != nil || mw.SiteId != dc.GetSiteID() {
+			continue
+		}
+		if req.ActiveOnly && !mw.IsActive(dateNow) {
+			continue
+		}
+		resultData = append(resultData, toMaintenanceWindowResponse(mw, dateNow))
+	}
+
+	resp.Data = resultData
+	return &resp, nil
+}
+
+// findMaintenanceWindow return index of maintenance window `id` on a site, -1 when not found.
+func findMaintenanceWindow(windows []models.MaintenanceWindow, siteId, id int) int {
+	for i, mw := range windows {
+		if mw.Id == id && mw.SiteId == siteId && mw.DeletedAt == nil {
+			return i
+		}
+	}
+	return -1
+}
+
+func toMaintenanceWindowResponse(mw models.MaintenanceWindow, now time.Time) response.GetDetailMaintenanceWindowResponse {
+	return response.GetDetailMaintenanceWindowResponse{
+		BaseResponse: response.BaseResponse{
+			Id:        mw.Id,
+			CreatedAt: mw.CreatedAt,
+			UpdatedAt: mw.UpdatedAt,
+			Version:   mw.Version,
+		},
+		SiteId:        mw.SiteId,
+		ParkingLotIds: mw.ParkingLotIds,
+		FloorId:       mw.FloorId,
+		StartAt:       mw.StartAt,
+		EndAt:         mw.EndAt,
+		Reason:        mw.Reason,
+		IsActive:      mw.IsActive(now),
+	}
+}
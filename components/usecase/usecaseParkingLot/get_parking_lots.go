@@ -2,15 +2,20 @@ package usecaseParkingLot
 
 import (
 	"encoding/json"
+	"time"
 
 	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
 	"github.com/mhaikalla/parking-service-management-library/components/models/request"
 	"github.com/mhaikalla/parking-service-management-library/components/models/response"
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
 	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
 )
 
 func (ctx *usecaseObj) GetParkingLots(dc contexts.BearerContext, req *request.GetParkingLotRequest) (*response.GetParkingLotsResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetParkingLots")
+	defer end()
+
 	resp := response.GetParkingLotsResponse{}
 
 	parkingLotData := []models.ParkingLot{}
@@ -40,8 +45,14 @@ func (ctx *usecaseObj) GetParkingLots(dc contexts.BearerContext, req *request.Ge
 				SetMessage(err.Error())
 		}
 	}
+	windowData := []models.MaintenanceWindow{}
+	if err := file.LoadTable(ctx.FileSystem, models.MaintenanceWindowTableName, &windowData); err != nil {
+		return nil, err
+	}
+	dateNow := time.Now().UTC()
+
 	for _, pld := range parkingLotData {
-		if pld.DeletedAt != nil {
+		if pld.DeletedAt != nil || pld.SiteId != dc.GetSiteID() {
 			continue
 		}
 
@@ -50,10 +61,16 @@ func (ctx *usecaseObj) GetParkingLots(dc contexts.BearerContext, req *request.Ge
 				Id:        pld.Id,
 				CreatedAt: pld.CreatedAt,
 				UpdatedAt: pld.UpdatedAt,
+				Version:   pld.Version,
 			},
-			Name:     pld.Name,
-			Floor:    pld.Floor,
-			IsParked: pld.IsParked,
+			SiteId:       pld.SiteId,
+			Name:         pld.Name,
+			Floor:        pld.Floor,
+			FloorId:      pld.FloorId,
+			ZoneId:       pld.ZoneId,
+			IsParked:     pld.IsParked,
+			Status:       pld.CurrentStatus(windowData, dateNow),
+			StatusReason: pld.StatusReason,
 		})
 	}
 
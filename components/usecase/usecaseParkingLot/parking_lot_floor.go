@@ -0,0 +1,112 @@
+package usecaseParkingLot
+
+import (
+	"strings"
+
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// floorResolver resolve floor and zone of parking lots of a site in memory. Floor only created through
+// floor API or migration, never from parking lot request.
+type floorResolver struct {
+	siteId int
+	floors []models.Floor
+	zones  []models.Zone
+}
+
+func (ctx *usecaseObj) newFloorResolver(siteId int) (*floorResolver, *errs.Errs) {
+	r := floorResolver{
+		siteId: siteId,
+		floors: []models.Floor{},
+		zones:  []models.Zone{},
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.FloorTableName, &r.floors); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.ZoneTableName, &r.zones); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// resolve find floor by `floorId`, or by `floorName` when id is empty, unknown floor rejected
+// so mistyped name never leave duplicate floor behind. `zoneId` must belong to resolved floor.
+func (r *floorResolver) resolve(floorId int, floorName string, zoneId int) (*models.Floor, *errs.Errs) {
+	idx := -1
+	for i, fl := range r.floors {
+		if fl.SiteId != r.siteId || fl.DeletedAt != nil {
+			continue
+		}
+		if (floorId != 0 && fl.Id == floorId) || (floorId == 0 && strings.EqualFold(fl.Name, floorName)) {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Floor Data Not Found")
+	}
+
+	if zoneId != 0 {
+		zoneFound := false
+		for _, zn := range r.zones {
+			if zn.Id == zoneId && zn.SiteId == r.siteId && zn.FloorId == r.floors[idx].Id && zn.DeletedAt == nil {
+				zoneFound = true
+				break
+			}
+		}
+		if !zoneFound {
+			return nil, errs.NewErrContext().
+				SetCode(errs.NotFound).
+				SetMessage("Zone Data Not Found On This Floor")
+		}
+	}
+	return &r.floors[idx], nil
+}
+
+// overCapacity return floor which active parking lots exceed its capacity, nil when all floors have room.
+func (r *floorResolver) overCapacity(parkingLots []models.ParkingLot) *models.Floor {
+	total := map[int]int{}
+	for _, pl := range parkingLots {
+		if pl.SiteId == r.siteId && pl.DeletedAt == nil {
+			total[pl.FloorId]++
+		}
+	}
+	for i, fl := range r.floors {
+		if fl.SiteId == r.siteId && fl.Capacity > 0 && total[fl.Id] > fl.Capacity {
+			return &r.floors[i]
+		}
+	}
+	return nil
+}
+
+// resolveFloor resolve floor of a single parking lot and make sure the floor still has room for it,
+// lot `lotId` itself is not counted.
+func (ctx *usecaseObj) resolveFloor(siteId, floorId int, floorName string, zoneId int, parkingLots []models.ParkingLot, lotId int) (*models.Floor, *errs.Errs) {
+	r, err := ctx.newFloorResolver(siteId)
+	if err != nil {
+		return nil, err
+	}
+	floor, err := r.resolve(floorId, floorName, zoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	if floor.Capacity > 0 {
+		totalParkingLot := 0
+		for _, pl := range parkingLots {
+			if pl.FloorId == floor.Id && pl.SiteId == siteId && pl.DeletedAt == nil && pl.Id != lotId {
+				totalParkingLot++
+			}
+		}
+		if totalParkingLot >= floor.Capacity {
+			return nil, errs.NewErrContext().
+				SetCode(errs.BadRequest).
+				SetMessage("Floor Capacity Has Been Reached")
+		}
+	}
+
+	return floor, nil
+}
@@ -0,0 +1,89 @@
+package usecaseParkingLot
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// SetParkingLotStatus take parking lot out of service or put it back, occupied state follow parked vehicle
+// so it can not be set here.
+func (ctx *usecaseObj) SetParkingLotStatus(dc contexts.BearerContext, req request.SetParkingLotStatusRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "SetParkingLotStatus")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ParkingLotTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	if !constant.LotManualStatus[req.Status] {
+		return nil, errs.NewErrContext().
+			SetCode(errs.BadRequest).
+			SetMessage("Invalid Parking Lot Status")
+	}
+
+	parkingLotData := []models.ParkingLot{}
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingLotTableName, &parkingLotData); err != nil {
+		return nil, err
+	}
+
+	id, errConv := strconv.Atoi(req.ParkingLotId)
+	if errConv != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errConv.Error())
+	}
+
+	idx := findParkingLot(parkingLotData, dc.GetSiteID(), strconv.Itoa(id))
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Data Not Found")
+	}
+	dateNow := time.Now().UTC()
+	if !parkingLotData[idx].MatchVersion(req.Version) {
+		windowData := []models.MaintenanceWindow{}
+		if err := file.LoadTable(ctx.FileSystem, models.MaintenanceWindowTableName, &windowData); err != nil {
+			return nil, err
+		}
+		resp.Message = "Conflict, Data Has Been Changed"
+		resp.Data = toParkingLotResponse(parkingLotData[idx], windowData, dateNow)
+		return &resp, errs.NewErrContext().
+			SetCode(errs.Conflict).
+			SetMessage("Data Has Been Changed, Reload And Retry")
+	}
+	if parkingLotData[idx].IsParked && req.Status != constant.LotAvailable {
+		return nil, errs.NewErrContext().
+			SetCode(errs.BadRequest).
+			SetMessage("This Parking Area Has Filled")
+	}
+
+	before := parkingLotData[idx]
+	parkingLotData[idx].Touch(dateNow)
+	parkingLotData[idx].Status = req.Status
+	parkingLotData[idx].StatusReason = req.Reason
+	if req.Status == constant.LotAvailable {
+		parkingLotData[idx].StatusReason = ""
+	}
+	version := parkingLotData[idx].Version
+	req.Version = &version
+
+	stat, err := ctx.FileSystem.SaveData(models.ParkingLotTableName, parkingLotData)
+	if !stat && err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditUpdate, models.ParkingLotTableName, before.Id, before, parkingLotData[idx])
+	resp.Message = "Success, Data Updated"
+	resp.Data = req
+	return &resp, nil
+}
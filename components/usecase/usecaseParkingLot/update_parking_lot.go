@@ -5,19 +5,27 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
 	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
 	"github.com/mhaikalla/parking-service-management-library/components/models/request"
 	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
 	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
 )
 
 func (ctx *usecaseObj) UpdateParkingLot(dc contexts.BearerContext, req request.UpdateParkingLotRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "UpdateParkingLot")
+	defer end()
+	defer ctx.FileSystem.Lock(models.ParkingLotTableName, models.FloorTableName)()
+
 	resp := response.BaseMessageResponse{
 		Message: "failed",
 	}
 	dateNow := time.Now().UTC()
 	parkingLotData := []models.ParkingLot{}
+	var before, after *models.ParkingLot
 	tableName := models.ParkingLotTableName
 	if !ctx.FileSystem.IsFileExisting(tableName) {
 		_, errCreate := ctx.FileSystem.CreateFile(tableName)
@@ -26,6 +34,10 @@ func (ctx *usecaseObj) UpdateParkingLot(dc contexts.BearerContext, req request.U
 				SetCode(errs.InternalServerError).
 				SetMessage(errCreate.Error())
 		}
+		floor, errFloor := ctx.resolveFloor(dc.GetSiteID(), req.FloorId, req.Floor, req.ZoneId, parkingLotData, 0)
+		if errFloor != nil {
+			return nil, errFloor
+		}
 		parkingLotData = append(parkingLotData, models.ParkingLot{
 			BaseEntity: models.BaseEntity{
 				Id:        len(parkingLotData) + 1,
@@ -33,7 +45,10 @@ func (ctx *usecaseObj) UpdateParkingLot(dc contexts.BearerContext, req request.U
 				UpdatedAt: dateNow,
 				DeletedAt: nil,
 			},
-			Floor:    req.Floor,
+			SiteId:   dc.GetSiteID(),
+			Floor:    floor.Name,
+			FloorId:  floor.Id,
+			ZoneId:   req.ZoneId,
 			Name:     req.Name,
 			IsParked: false,
 		})
@@ -57,21 +72,43 @@ func (ctx *usecaseObj) UpdateParkingLot(dc contexts.BearerContext, req request.U
 					SetMessage(errConv.Error())
 			}
 
-			if pl.Id == id {
+			if pl.Id == id && pl.SiteId == dc.GetSiteID() {
 				if pl.DeletedAt != nil {
 					return nil, errs.NewErrContext().
 						SetCode(errs.NotFound).
 						SetMessage("Data Not Found")
 				}
+				if !pl.MatchVersion(req.Version) {
+					windowData := []models.MaintenanceWindow{}
+					if err := file.LoadTable(ctx.FileSystem, models.MaintenanceWindowTableName, &windowData); err != nil {
+						return nil, err
+					}
+					resp.Message = "Conflict, Data Has Been Changed"
+					resp.Data = toParkingLotResponse(pl, windowData, dateNow)
+					return &resp, errs.NewErrContext().
+						SetCode(errs.Conflict).
+						SetMessage("Data Has Been Changed, Reload And Retry")
+				}
 				if pl.IsParked {
 					return nil, errs.NewErrContext().
 						SetCode(errs.NotFound).
 						SetMessage("This Parking Area Has Filled")
 				}
 
-				parkingLotData[i].UpdatedAt = dateNow
-				parkingLotData[i].Floor = req.Floor
+				floor, errFloor := ctx.resolveFloor(dc.GetSiteID(), req.FloorId, req.Floor, req.ZoneId, parkingLotData, pl.Id)
+				if errFloor != nil {
+					return nil, errFloor
+				}
+
+				before = &pl
+				parkingLotData[i].Touch(dateNow)
+				parkingLotData[i].Floor = floor.Name
+				parkingLotData[i].FloorId = floor.Id
+				parkingLotData[i].ZoneId = req.ZoneId
 				parkingLotData[i].Name = req.Name
+				version := parkingLotData[i].Version
+				req.Version = &version
+				after = &parkingLotData[i]
 				break
 			}
 		}
@@ -82,6 +119,9 @@ func (ctx *usecaseObj) UpdateParkingLot(dc contexts.BearerContext, req request.U
 			SetCode(errs.InternalServerError).
 			SetMessage(err.Error())
 	}
+	if before != nil {
+		audit.Record(ctx.Audit, dc, constant.AuditUpdate, tableName, before.Id, before, after)
+	}
 	resp.Message = "Success, Data Updated"
 	resp.Data = req
 	return &resp, nil
@@ -3,6 +3,7 @@ package usecaseParkingLot
 import (
 	"github.com/mhaikalla/parking-service-management-library/components/models/request"
 	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
 	"github.com/mhaikalla/parking-service-management-library/pkg/file"
 
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
@@ -15,10 +16,19 @@ type IUsecaseParkingLot interface {
 	DeleteParkingLots(dc contexts.BearerContext, req *request.DeleteParkingLotRequest) (*response.BaseMessageResponse, *errs.Errs)
 	GetParkingLots(dc contexts.BearerContext, req *request.GetParkingLotRequest) (*response.GetParkingLotsResponse, *errs.Errs)
 	GetDetailParkingLot(dc contexts.BearerContext, req *request.GetDetailParkingLotRequest) (*response.GetDetailParkingLotResponse, *errs.Errs)
+	CreateBulkParkingLots(dc contexts.BearerContext, req request.CreateBulkParkingLotRequest) (*response.BaseMessageResponse, *errs.Errs)
+	UpdateBulkParkingLots(dc contexts.BearerContext, req request.UpdateBulkParkingLotRequest) (*response.BaseMessageResponse, *errs.Errs)
+	SetParkingLotStatus(dc contexts.BearerContext, req request.SetParkingLotStatusRequest) (*response.BaseMessageResponse, *errs.Errs)
+	CreateMaintenanceWindow(dc contexts.BearerContext, req request.CreateMaintenanceWindowRequest) (*response.BaseMessageResponse, *errs.Errs)
+	DeleteMaintenanceWindow(dc contexts.BearerContext, req *request.DeleteMaintenanceWindowRequest) (*response.BaseMessageResponse, *errs.Errs)
+	GetMaintenanceWindows(dc contexts.BearerContext, req *request.GetMaintenanceWindowRequest) (*response.GetMaintenanceWindowsResponse, *errs.Errs)
+	GetDetailMaintenanceWindow(dc contexts.BearerContext, req *request.GetDetailMaintenanceWindowRequest) (*response.GetDetailMaintenanceWindowResponse, *errs.Errs)
+	DeleteBulkParkingLots(dc contexts.BearerContext, req *request.DeleteBulkParkingLotRequest) (*response.BaseMessageResponse, *errs.Errs)
 }
 
 type usecaseObj struct {
 	FileSystem file.IFileSystem
+	Audit      audit.Trail
 }
 
 func NewParkingLotUsecase(ctx ...interface{}) IUsecaseParkingLot {
@@ -27,7 +37,17 @@ func NewParkingLotUsecase(ctx ...interface{}) IUsecaseParkingLot {
 		switch c.(type) {
 		case file.IFileSystem:
 			handle.FileSystem = c.(file.IFileSystem)
+		case audit.Trail:
+			handle.Audit = c.(audit.Trail)
 		}
 	}
 	return &handle
 }
+
+// traced copy of usecase with storage traced under span `name`, span ended by returned func.
+func (ctx *usecaseObj) traced(dc contexts.BearerContext, name string) (*usecaseObj, func()) {
+	spanCtx, end := dc.StartSpan("UsecaseParkingLot." + name)
+	traced := *ctx
+	traced.FileSystem = file.WithTracing(ctx.FileSystem, spanCtx)
+	return &traced, end
+}
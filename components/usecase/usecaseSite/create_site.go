@@ -0,0 +1,62 @@
+package usecaseSite
+
+import (
+	"strings"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) CreateSite(dc contexts.BearerContext, req request.CreateSiteRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "CreateSite")
+	defer end()
+	defer ctx.FileSystem.Lock(models.SiteTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	siteData := []models.Site{}
+	if err := file.LoadTable(ctx.FileSystem, models.SiteTableName, &siteData); err != nil {
+		return nil, err
+	}
+
+	for _, sd := range siteData {
+		if sd.DeletedAt == nil && strings.EqualFold(sd.Code, req.Code) {
+			return nil, errs.NewErrContext().
+				SetCode(errs.Conflict).
+				SetMessage("Site Code Already Exists")
+		}
+	}
+
+	dateNow := time.Now().UTC()
+
+	siteData = append(siteData, models.Site{
+		BaseEntity: models.BaseEntity{
+			Id:        len(siteData) + 1,
+			CreatedAt: dateNow,
+			UpdatedAt: dateNow,
+			DeletedAt: nil,
+		},
+		Code:    req.Code,
+		Name:    req.Name,
+		Address: req.Address,
+	})
+	stat, err := ctx.FileSystem.SaveData(models.SiteTableName, siteData)
+	if !stat && err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	created := siteData[len(siteData)-1]
+	audit.Record(ctx.Audit, dc, constant.AuditCreate, models.SiteTableName, created.Id, nil, created)
+	resp.Message = "Success"
+	resp.Data = req
+	return &resp, nil
+}
@@ -0,0 +1,75 @@
+package usecaseSite
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) DeleteSite(dc contexts.BearerContext, req *request.DeleteSiteRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "DeleteSite")
+	defer end()
+	defer ctx.FileSystem.Lock(models.SiteTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	siteData := []models.Site{}
+	parkingLotData := []models.ParkingLot{}
+	if err := file.LoadTable(ctx.FileSystem, models.SiteTableName, &siteData); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingLotTableName, &parkingLotData); err != nil {
+		return nil, err
+	}
+
+	id, errConv := strconv.Atoi(req.SiteId)
+	if errConv != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errConv.Error())
+	}
+
+	for _, pl := range parkingLotData {
+		if pl.SiteId == id && pl.DeletedAt == nil {
+			return nil, errs.NewErrContext().
+				SetCode(errs.BadRequest).
+				SetMessage("This Site Still Has Parking Area")
+		}
+	}
+
+	idx := -1
+	for i, sd := range siteData {
+		if sd.Id == id && sd.DeletedAt == nil {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Data Not Found")
+	}
+
+	before := siteData[idx]
+	siteData[idx].MarkDeleted(time.Now().UTC())
+
+	stat, err := ctx.FileSystem.SaveData(models.SiteTableName, siteData)
+	if !stat && err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditDelete, models.SiteTableName, before.Id, before, nil)
+	resp.Message = "Success"
+	resp.Data = nil
+	return &resp, nil
+}
@@ -0,0 +1,41 @@
+package usecaseSite
+
+import (
+	"strconv"
+
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) GetDetailSite(dc contexts.BearerContext, req *request.GetDetailSiteRequest) (*response.GetDetailSiteResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetDetailSite")
+	defer end()
+
+	siteData := []models.Site{}
+	if err := file.LoadTable(ctx.FileSystem, models.SiteTableName, &siteData); err != nil {
+		return nil, err
+	}
+
+	id, errConv := strconv.Atoi(req.SiteId)
+	if errConv != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errConv.Error())
+	}
+
+	for _, sd := range siteData {
+		// site caller not allowed on reported as not found
+		if sd.Id == id && sd.DeletedAt == nil && dc.CanAccessSite(sd.Id) {
+			resp := toSiteResponse(sd)
+			return &resp, nil
+		}
+	}
+
+	return nil, errs.NewErrContext().
+		SetCode(errs.NotFound).
+		SetMessage("Data Not Found")
+}
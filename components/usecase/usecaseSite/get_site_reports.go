@@ -0,0 +1,114 @@
+package usecaseSite
+
+import (
+	"sort"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// GetSiteReports aggregate parking area occupancy and revenue of every site for head office.
+// Data without site (site ID 0) reported as `DEFAULT` site.
+func (ctx *usecaseObj) GetSiteReports(dc contexts.BearerContext) (*response.GetSiteReportsResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetSiteReports")
+	defer end()
+
+	siteData := []models.Site{}
+	parkingLotData := []models.ParkingLot{}
+	parkingStatusData := []models.ParkingVehicleStatus{}
+	floorData := []models.Floor{}
+	windowData := []models.MaintenanceWindow{}
+
+	if err := file.LoadTable(ctx.FileSystem, models.SiteTableName, &siteData); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingLotTableName, &parkingLotData); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.ParkingVehicleStatusTableName, &parkingStatusData); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.FloorTableName, &floorData); err != nil {
+		return nil, err
+	}
+	if err := file.LoadTable(ctx.FileSystem, models.MaintenanceWindowTableName, &windowData); err != nil {
+		return nil, err
+	}
+
+	reports := map[int]*response.SiteReportResponse{}
+	getReport := func(siteId int) *response.SiteReportResponse {
+		if r, ok := reports[siteId]; ok {
+			return r
+		}
+		reports[siteId] = &response.SiteReportResponse{SiteId: siteId, Code: "DEFAULT", Name: "DEFAULT"}
+		return reports[siteId]
+	}
+
+	for _, sd := range siteData {
+		if sd.DeletedAt != nil {
+			continue
+		}
+		r := getReport(sd.Id)
+		r.Code = sd.Code
+		r.Name = sd.Name
+	}
+
+	closedFloors := map[int]bool{}
+	for _, fl := range floorData {
+		closedFloors[fl.Id] = fl.IsClosed || fl.DeletedAt != nil
+	}
+
+	dateNow := time.Now().UTC()
+	for _, pl := range parkingLotData {
+		if pl.DeletedAt != nil {
+			continue
+		}
+		r := getReport(pl.SiteId)
+		r.TotalParkingLot++
+		switch status := pl.CurrentStatus(windowData, dateNow); {
+		case status == constant.LotOccupied:
+			r.OccupiedParkingLot++
+		case status == constant.LotAvailable && !closedFloors[pl.FloorId]:
+			r.AvailableParkingLot++
+		default:
+			r.UnavailableParkingLot++
+		}
+	}
+
+	for _, ps := range parkingStatusData {
+		if ps.DeletedAt != nil {
+			continue
+		}
+		r := getReport(ps.SiteId)
+		switch ps.Status {
+		case constant.ParkingIn:
+			r.TotalParkingIn++
+		case constant.ParkingOut:
+			r.TotalParkingOut++
+			r.Revenue += ps.Price
+		}
+	}
+
+	resp := response.GetSiteReportsResponse{
+		Data:  []response.SiteReportResponse{},
+		Total: response.SiteReportResponse{Code: "ALL", Name: "ALL"},
+	}
+	for _, r := range reports {
+		resp.Data = append(resp.Data, *r)
+		resp.Total.TotalParkingLot += r.TotalParkingLot
+		resp.Total.OccupiedParkingLot += r.OccupiedParkingLot
+		resp.Total.AvailableParkingLot += r.AvailableParkingLot
+		resp.Total.UnavailableParkingLot += r.UnavailableParkingLot
+		resp.Total.TotalParkingIn += r.TotalParkingIn
+		resp.Total.TotalParkingOut += r.TotalParkingOut
+		resp.Total.Revenue += r.Revenue
+	}
+	sort.Slice(resp.Data, func(i, j int) bool { return resp.Data[i].SiteId < resp.Data[j].SiteId })
+
+	return &resp, nil
+}
@@ -0,0 +1,47 @@
+package usecaseSite
+
+import (
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) GetSites(dc contexts.BearerContext, req *request.GetSiteRequest) (*response.GetSitesResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetSites")
+	defer end()
+
+	resp := response.GetSitesResponse{}
+	siteData := []models.Site{}
+	resultData := []response.GetDetailSiteResponse{}
+
+	if err := file.LoadTable(ctx.FileSystem, models.SiteTableName, &siteData); err != nil {
+		return nil, err
+	}
+
+	for _, sd := range siteData {
+		if sd.DeletedAt != nil || !dc.CanAccessSite(sd.Id) {
+			continue
+		}
+		resultData = append(resultData, toSiteResponse(sd))
+	}
+
+	resp.Data = resultData
+	return &resp, nil
+}
+
+func toSiteResponse(sd models.Site) response.GetDetailSiteResponse {
+	return response.GetDetailSiteResponse{
+		BaseResponse: response.BaseResponse{
+			Id:        sd.Id,
+			CreatedAt: sd.CreatedAt,
+			UpdatedAt: sd.UpdatedAt,
+			Version:   sd.Version,
+		},
+		Code:    sd.Code,
+		Name:    sd.Name,
+		Address: sd.Address,
+	}
+}
@@ -0,0 +1,61 @@
+package usecaseSite
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+	"github.com/mhaikalla/parking-service-management-library/pkg/jwt"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type GetSitesSuite struct {
+	suite.Suite
+	usecase IUsecaseSite
+}
+
+func (s *GetSitesSuite) SetupTest() {
+	s.usecase = NewSiteUsecase(file.NewFileSystem(s.T().TempDir() + "/"))
+	headOffice := s.caller(jwt.JWTClaims{AllSites: true})
+	for _, code := range []string{"JKT", "BDG"} {
+		_, err := s.usecase.CreateSite(headOffice, request.CreateSiteRequest{Code: code, Name: code})
+		s.Require().Nil(err)
+	}
+}
+
+func (s *GetSitesSuite) caller(claims jwt.JWTClaims) contexts.BearerContext {
+	return contexts.BearerContext{SideLoad: contexts.SideLoad{BearerData: claims}}
+}
+
+func (s *GetSitesSuite) TestOwnSiteOnly() {
+	dc := s.caller(jwt.JWTClaims{SiteID: 2})
+	resp, err := s.usecase.GetSites(dc, &request.GetSiteRequest{})
+	s.Require().Nil(err)
+	s.Require().Len(resp.Data, 1)
+	s.Equal("BDG", resp.Data[0].Code)
+
+	_, err = s.usecase.GetDetailSite(dc, &request.GetDetailSiteRequest{SiteId: "1"})
+	s.Require().NotNil(err, "other site not readable")
+	s.Equal(strconv.Itoa(errs.NotFound), err.Code)
+	detail, err := s.usecase.GetDetailSite(dc, &request.GetDetailSiteRequest{SiteId: "2"})
+	s.Require().Nil(err)
+	s.Equal("BDG", detail.Code)
+}
+
+func (s *GetSitesSuite) TestAllSites() {
+	dc := s.caller(jwt.JWTClaims{AllSites: true})
+	resp, err := s.usecase.GetSites(dc, &request.GetSiteRequest{})
+	s.Require().Nil(err)
+	s.Len(resp.Data, 2)
+
+	_, err = s.usecase.GetDetailSite(dc, &request.GetDetailSiteRequest{SiteId: "1"})
+	s.Nil(err)
+}
+
+func TestGetSitesSuite(t *testing.T) {
+	suite.Run(t, new(GetSitesSuite))
+}
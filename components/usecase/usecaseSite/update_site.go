@@ -0,0 +1,84 @@
+package usecaseSite
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+func (ctx *usecaseObj) UpdateSite(dc contexts.BearerContext, req request.UpdateSiteRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "UpdateSite")
+	defer end()
+	defer ctx.FileSystem.Lock(models.SiteTableName)()
+
+	resp := response.BaseMessageResponse{
+		Message: "failed",
+	}
+	siteData := []models.Site{}
+	if err := file.LoadTable(ctx.FileSystem, models.SiteTableName, &siteData); err != nil {
+		return nil, err
+	}
+
+	id, errConv := strconv.Atoi(req.Id)
+	if errConv != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errConv.Error())
+	}
+
+	idx := -1
+	for i, sd := range siteData {
+		if sd.DeletedAt != nil {
+			continue
+		}
+		if sd.Id == id {
+			idx = i
+			continue
+		}
+		if strings.EqualFold(sd.Code, req.Code) {
+			return nil, errs.NewErrContext().
+				SetCode(errs.Conflict).
+				SetMessage("Site Code Already Exists")
+		}
+	}
+	if idx < 0 {
+		return nil, errs.NewErrContext().
+			SetCode(errs.NotFound).
+			SetMessage("Data Not Found")
+	}
+	if !siteData[idx].MatchVersion(req.Version) {
+		resp.Message = "Conflict, Data Has Been Changed"
+		resp.Data = toSiteResponse(siteData[idx])
+		return &resp, errs.NewErrContext().
+			SetCode(errs.Conflict).
+			SetMessage("Data Has Been Changed, Reload And Retry")
+	}
+
+	before := siteData[idx]
+	siteData[idx].Touch(time.Now().UTC())
+	siteData[idx].Code = req.Code
+	siteData[idx].Name = req.Name
+	siteData[idx].Address = req.Address
+	version := siteData[idx].Version
+	req.Version = &version
+
+	stat, err := ctx.FileSystem.SaveData(models.SiteTableName, siteData)
+	if !stat && err != nil {
+		return nil, errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	audit.Record(ctx.Audit, dc, constant.AuditUpdate, models.SiteTableName, before.Id, before, siteData[idx])
+	resp.Message = "Success, Data Updated"
+	resp.Data = req
+	return &resp, nil
+}
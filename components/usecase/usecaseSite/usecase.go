@@ -0,0 +1,46 @@
+package usecaseSite
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+)
+
+type IUsecaseSite interface {
+	CreateSite(dc contexts.BearerContext, req request.CreateSiteRequest) (*response.BaseMessageResponse, *errs.Errs)
+	UpdateSite(dc contexts.BearerContext, req request.UpdateSiteRequest) (*response.BaseMessageResponse, *errs.Errs)
+	DeleteSite(dc contexts.BearerContext, req *request.DeleteSiteRequest) (*response.BaseMessageResponse, *errs.Errs)
+	GetSites(dc contexts.BearerContext, req *request.GetSiteRequest) (*response.GetSitesResponse, *errs.Errs)
+	GetDetailSite(dc contexts.BearerContext, req *request.GetDetailSiteRequest) (*response.GetDetailSiteResponse, *errs.Errs)
+	GetSiteReports(dc contexts.BearerContext) (*response.GetSiteReportsResponse, *errs.Errs)
+}
+
+type usecaseObj struct {
+	FileSystem file.IFileSystem
+	Audit      audit.Trail
+}
+
+func NewSiteUsecase(ctx ...interface{}) IUsecaseSite {
+	handle := usecaseObj{}
+	for _, c := range ctx {
+		switch c.(type) {
+		case file.IFileSystem:
+			handle.FileSystem = c.(file.IFileSystem)
+		case audit.Trail:
+			handle.Audit = c.(audit.Trail)
+		}
+	}
+	return &handle
+}
+
+// traced copy of usecase with storage traced under span `name`, span ended by returned func.
+func (ctx *usecaseObj) traced(dc contexts.BearerContext, name string) (*usecaseObj, func()) {
+	spanCtx, end := dc.StartSpan("UsecaseSite." + name)
+	traced := *ctx
+	traced.FileSystem = file.WithTracing(ctx.FileSystem, spanCtx)
+	return &traced, end
+}
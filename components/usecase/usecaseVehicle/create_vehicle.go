@@ -4,14 +4,20 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
 	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
 	"github.com/mhaikalla/parking-service-management-library/components/models/request"
 	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
 	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
 )
 
 func (ctx *usecaseObj) CreateVehicle(dc contexts.BearerContext, req request.CreateVehicleRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "CreateVehicle")
+	defer end()
+	defer ctx.FileSystem.Lock(models.VehicleTableName)()
+
 	resp := response.BaseMessageResponse{
 		Message: "failed",
 	}
@@ -48,6 +54,7 @@ func (ctx *usecaseObj) CreateVehicle(dc contexts.BearerContext, req request.Crea
 			UpdatedAt: dateNow,
 			DeletedAt: nil,
 		},
+		SiteId:              dc.GetSiteID(),
 		Name:                req.Name,
 		Type:                req.Type,
 		FirstHourPrice:      req.FirstHourPrice,
@@ -59,6 +66,8 @@ func (ctx *usecaseObj) CreateVehicle(dc contexts.BearerContext, req request.Crea
 			SetCode(errs.InternalServerError).
 			SetMessage(err.Error())
 	}
+	created := vehicleData[len(vehicleData)-1]
+	audit.Record(ctx.Audit, dc, constant.AuditCreate, tableName, created.Id, nil, created)
 	resp.Message = "Success"
 	resp.Data = req
 
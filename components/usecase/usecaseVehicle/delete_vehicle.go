@@ -5,20 +5,27 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
 	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
 	"github.com/mhaikalla/parking-service-management-library/components/models/request"
 	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
 	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
 )
 
 func (ctx *usecaseObj) DeleteVehicles(dc contexts.BearerContext, req *request.DeleteVehicleRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "DeleteVehicles")
+	defer end()
+	defer ctx.FileSystem.Lock(models.VehicleTableName)()
+
 	resp := response.BaseMessageResponse{
 		Message: "failed",
 	}
 	tableName := models.VehicleTableName
 	dateNow := time.Now().UTC()
 	vehicleData := []models.Vehicle{}
+	var before *models.Vehicle
 
 	if !ctx.FileSystem.IsFileExisting(tableName) {
 		_, errCreate := ctx.FileSystem.CreateFile(tableName)
@@ -49,13 +56,14 @@ func (ctx *usecaseObj) DeleteVehicles(dc contexts.BearerContext, req *request.De
 				SetMessage(errConv.Error())
 		}
 		for i, pl := range vehicleData {
-			if pl.Id == id {
+			if pl.Id == id && pl.SiteId == dc.GetSiteID() {
 				if pl.DeletedAt != nil {
 					return nil, errs.NewErrContext().
 						SetCode(errs.NotFound).
 						SetMessage("Data Not Found")
 				}
-				vehicleData[i].DeletedAt = &dateNow
+				before = &pl
+				vehicleData[i].MarkDeleted(dateNow)
 				break
 			}
 		}
@@ -66,6 +74,9 @@ func (ctx *usecaseObj) DeleteVehicles(dc contexts.BearerContext, req *request.De
 			SetCode(errs.InternalServerError).
 			SetMessage(err.Error())
 	}
+	if before != nil {
+		audit.Record(ctx.Audit, dc, constant.AuditDelete, tableName, before.Id, before, nil)
+	}
 	resp.Message = "Success"
 	resp.Data = nil
 	return &resp, nil
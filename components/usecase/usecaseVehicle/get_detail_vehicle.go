@@ -13,6 +13,9 @@ import (
 )
 
 func (ctx *usecaseObj) GetDetailVehicle(dc contexts.BearerContext, req *request.GetDetailVehicleRequest) (*response.GetDetailVehicleResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetDetailVehicle")
+	defer end()
+
 	resp := response.GetDetailVehicleResponse{}
 
 	vehicleData := []models.Vehicle{}
@@ -49,7 +52,7 @@ func (ctx *usecaseObj) GetDetailVehicle(dc contexts.BearerContext, req *request.
 	}
 
 	for _, vd := range vehicleData {
-		if vd.Id == id {
+		if vd.Id == id && vd.SiteId == dc.GetSiteID() {
 			if vd.DeletedAt != nil {
 				return nil, errs.NewErrContext().
 					SetCode(errs.NotFound).
@@ -65,17 +68,23 @@ func (ctx *usecaseObj) GetDetailVehicle(dc contexts.BearerContext, req *request.
 			SetMessage("Data Not Found")
 	}
 
-	resp = response.GetDetailVehicleResponse{
+	resp = toVehicleResponse(resultData)
+
+	return &resp, nil
+}
+
+func toVehicleResponse(vd models.Vehicle) response.GetDetailVehicleResponse {
+	return response.GetDetailVehicleResponse{
 		BaseResponse: response.BaseResponse{
-			Id:        resultData.Id,
-			CreatedAt: resultData.CreatedAt,
-			UpdatedAt: resultData.UpdatedAt,
+			Id:        vd.Id,
+			CreatedAt: vd.CreatedAt,
+			UpdatedAt: vd.UpdatedAt,
+			Version:   vd.Version,
 		},
-		Name:                resultData.Name,
-		Type:                resultData.Type,
-		FirstHourPrice:      resultData.FirstHourPrice,
-		PricePerHourPercent: resultData.PricePerHourPercent,
+		SiteId:              vd.SiteId,
+		Name:                vd.Name,
+		Type:                vd.Type,
+		FirstHourPrice:      vd.FirstHourPrice,
+		PricePerHourPercent: vd.PricePerHourPercent,
 	}
-
-	return &resp, nil
 }
@@ -11,6 +11,9 @@ import (
 )
 
 func (ctx *usecaseObj) GetVehicles(dc contexts.BearerContext, req *request.GetVehicleRequest) (*response.GetVehiclesResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "GetVehicles")
+	defer end()
+
 	resp := response.GetVehiclesResponse{}
 
 	vehicleData := []models.Vehicle{}
@@ -41,7 +44,7 @@ func (ctx *usecaseObj) GetVehicles(dc contexts.BearerContext, req *request.GetVe
 		}
 	}
 	for _, pld := range vehicleData {
-		if pld.DeletedAt != nil {
+		if pld.DeletedAt != nil || pld.SiteId != dc.GetSiteID() {
 			continue
 		}
 
@@ -50,7 +53,9 @@ func (ctx *usecaseObj) GetVehicles(dc contexts.BearerContext, req *request.GetVe
 				Id:        pld.Id,
 				CreatedAt: pld.CreatedAt,
 				UpdatedAt: pld.UpdatedAt,
+				Version:   pld.Version,
 			},
+			SiteId:              pld.SiteId,
 			Name:                pld.Name,
 			Type:                pld.Type,
 			FirstHourPrice:      pld.FirstHourPrice,
@@ -4,14 +4,20 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
 	models "github.com/mhaikalla/parking-service-management-library/components/models/entity"
 	"github.com/mhaikalla/parking-service-management-library/components/models/request"
 	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
 	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
 )
 
 func (ctx *usecaseObj) UpdateVehicle(dc contexts.BearerContext, req request.UpdateVehicleRequest) (*response.BaseMessageResponse, *errs.Errs) {
+	ctx, end := ctx.traced(dc, "UpdateVehicle")
+	defer end()
+	defer ctx.FileSystem.Lock(models.VehicleTableName)()
+
 	resp := response.BaseMessageResponse{
 		Message: "failed",
 	}
@@ -32,6 +38,7 @@ func (ctx *usecaseObj) UpdateVehicle(dc contexts.BearerContext, req request.Upda
 				UpdatedAt: dateNow,
 				DeletedAt: nil,
 			},
+			SiteId:              dc.GetSiteID(),
 			Type:                req.Type,
 			Name:                req.Name,
 			FirstHourPrice:      req.FirstHourPrice,
@@ -51,18 +58,30 @@ func (ctx *usecaseObj) UpdateVehicle(dc contexts.BearerContext, req request.Upda
 		}
 	}
 
+	updated := -1
+	before := models.Vehicle{}
 	for i, pl := range vehicleData {
-		if pl.Id == req.Id {
+		if pl.Id == req.Id && pl.SiteId == dc.GetSiteID() {
 			if pl.DeletedAt != nil {
 				return nil, errs.NewErrContext().
 					SetCode(errs.NotFound).
 					SetMessage("Data Not Found")
 			}
-			vehicleData[i].UpdatedAt = dateNow
+			if !pl.MatchVersion(req.Version) {
+				resp.Message = "Conflict, Data Has Been Changed"
+				resp.Data = toVehicleResponse(pl)
+				return &resp, errs.NewErrContext().
+					SetCode(errs.Conflict).
+					SetMessage("Data Has Been Changed, Reload And Retry")
+			}
+			updated, before = i, pl
+			vehicleData[i].Touch(dateNow)
 			vehicleData[i].FirstHourPrice = req.FirstHourPrice
 			vehicleData[i].PricePerHourPercent = req.PricePerHourPercent
 			vehicleData[i].Type = req.Type
 			vehicleData[i].Name = req.Name
+			version := vehicleData[i].Version
+			req.Version = &version
 			break
 		}
 	}
@@ -72,6 +91,9 @@ func (ctx *usecaseObj) UpdateVehicle(dc contexts.BearerContext, req request.Upda
 			SetCode(errs.InternalServerError).
 			SetMessage(err.Error())
 	}
+	if updated >= 0 {
+		audit.Record(ctx.Audit, dc, constant.AuditUpdate, tableName, before.Id, before, vehicleData[updated])
+	}
 	resp.Message = "Success, Data Updated"
 	resp.Data = req
 	return &resp, nil
@@ -0,0 +1,75 @@
+package usecaseVehicle
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/mhaikalla/parking-service-management-library/components/models/request"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateVehicleSuite struct {
+	suite.Suite
+	usecase IUsecaseVehicle
+	dc      contexts.BearerContext
+}
+
+func (s *UpdateVehicleSuite) SetupTest() {
+	s.usecase = NewVehicleUsecase(file.NewFileSystem(s.T().TempDir() + "/"))
+	_, err := s.usecase.CreateVehicle(s.dc, request.CreateVehicleRequest{
+		Name: "SUV", Type: "SUV", FirstHourPrice: 5000, PricePerHourPercent: 10,
+	})
+	s.Require().Nil(err)
+}
+
+func (s *UpdateVehicleSuite) TestConcurrentUpdateSameVersion() {
+	detail, err := s.usecase.GetDetailVehicle(s.dc, &request.GetDetailVehicleRequest{VehicleId: "1"})
+	s.Require().Nil(err)
+	version := detail.Version
+
+	const writers = 50
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	results := make(chan *errs.Errs, writers)
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(price int) {
+			defer wg.Done()
+			<-start
+			v := version
+			_, err := s.usecase.UpdateVehicle(s.dc, request.UpdateVehicleRequest{
+				Id: 1, Name: "SUV", Type: "SUV", FirstHourPrice: price, PricePerHourPercent: 10, Version: &v,
+			})
+			results <- err
+		}(6000 + i)
+	}
+	close(start)
+	wg.Wait()
+	close(results)
+
+	updated, conflicts := 0, 0
+	for err := range results {
+		switch {
+		case err == nil:
+			updated++
+		case err.Code == "409":
+			conflicts++
+		default:
+			s.Failf("unexpected error", "%s", err.Message)
+		}
+	}
+	s.Equal(1, updated, "only one writer of same version win, others never silently overwritten")
+	s.Equal(writers-1, conflicts)
+
+	detail, err = s.usecase.GetDetailVehicle(s.dc, &request.GetDetailVehicleRequest{VehicleId: "1"})
+	s.Require().Nil(err)
+	s.Equal(version+1, detail.Version)
+}
+
+func TestUpdateVehicleSuite(t *testing.T) {
+	suite.Run(t, new(UpdateVehicleSuite))
+}
@@ -3,6 +3,7 @@ package usecaseVehicle
 import (
 	"github.com/mhaikalla/parking-service-management-library/components/models/request"
 	"github.com/mhaikalla/parking-service-management-library/components/models/response"
+	"github.com/mhaikalla/parking-service-management-library/pkg/audit"
 	"github.com/mhaikalla/parking-service-management-library/pkg/file"
 
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
@@ -19,6 +20,7 @@ type IUsecaseVehicle interface {
 
 type usecaseObj struct {
 	FileSystem file.IFileSystem
+	Audit      audit.Trail
 }
 
 func NewVehicleUsecase(ctx ...interface{}) IUsecaseVehicle {
@@ -27,7 +29,17 @@ func NewVehicleUsecase(ctx ...interface{}) IUsecaseVehicle {
 		switch c.(type) {
 		case file.IFileSystem:
 			handle.FileSystem = c.(file.IFileSystem)
+		case audit.Trail:
+			handle.Audit = c.(audit.Trail)
 		}
 	}
 	return &handle
 }
+
+// traced copy of usecase with storage traced under span `name`, span ended by returned func.
+func (ctx *usecaseObj) traced(dc contexts.BearerContext, name string) (*usecaseObj, func()) {
+	spanCtx, end := dc.StartSpan("UsecaseVehicle." + name)
+	traced := *ctx
+	traced.FileSystem = file.WithTracing(ctx.FileSystem, spanCtx)
+	return &traced, end
+}
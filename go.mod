@@ -3,6 +3,7 @@ module github.com/mhaikalla/parking-service-management-library
 go 1.16
 
 require (
+	github.com/fsnotify/fsnotify v1.7.0
 	github.com/go-playground/validator/v10 v10.19.0
 	github.com/google/uuid v1.6.0
 	github.com/jinzhu/gorm v1.9.16
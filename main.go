@@ -2,20 +2,40 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"os/signal"
 	"runtime/debug"
-	"time"
+	"strings"
 
+	"github.com/mhaikalla/parking-service-management-library/components/cli"
+	"github.com/mhaikalla/parking-service-management-library/components/constant"
+	apiDocHandler "github.com/mhaikalla/parking-service-management-library/components/handlers/apidoc"
+	auditLogHandler "github.com/mhaikalla/parking-service-management-library/components/handlers/auditlog"
+	authHandler "github.com/mhaikalla/parking-service-management-library/components/handlers/auth"
+	floorHandler "github.com/mhaikalla/parking-service-management-library/components/handlers/floor"
+	healthHandler "github.com/mhaikalla/parking-service-management-library/components/handlers/health"
 	parkingHandler "github.com/mhaikalla/parking-service-management-library/components/handlers/parking"
 	parkingLotHandler "github.com/mhaikalla/parking-service-management-library/components/handlers/parkinglot"
+	siteHandler "github.com/mhaikalla/parking-service-management-library/components/handlers/site"
 	vehicleHandler "github.com/mhaikalla/parking-service-management-library/components/handlers/vehicle"
+	"github.com/mhaikalla/parking-service-management-library/components/migration"
+	parkingUsecase "github.com/mhaikalla/parking-service-management-library/components/usecase/usecaseParking"
 	"github.com/mhaikalla/parking-service-management-library/pkg/condutils"
 	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+	"github.com/mhaikalla/parking-service-management-library/pkg/lifecycle"
 	"github.com/mhaikalla/parking-service-management-library/pkg/router"
+	"github.com/mhaikalla/parking-service-management-library/pkg/secrets"
+	"github.com/mhaikalla/parking-service-management-library/pkg/tracing"
 	validatorRequest "github.com/mhaikalla/parking-service-management-library/pkg/validator"
 
+	validation "github.com/go-playground/validator/v10"
+
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
@@ -31,8 +51,62 @@ var (
 )
 
 func main() {
+	flag.StringVar(&configFile, "config", condutils.Or(os.Getenv(config.EnvPrefix+"CONFIG"), configFile).(string),
+		"config files merged in order, comma separated (env PSM_CONFIG)")
+	profile := flag.String("profile", os.Getenv(config.EnvPrefix+"PROFILE"),
+		"profile file merged over config, eg: dev, staging, prod (env PSM_PROFILE)")
+	watchConfig := flag.Bool("watch-config", true,
+		"reload queue, rate limits, log level and feature flags when config files change, see config.Reloadable")
+	printConfig := flag.Bool("print-config", false, "print config loaded with secrets masked then exit")
+	keystoreSet := flag.String("keystore-set", "",
+		"store secret KEY read from stdin on keystore of secret_store.keystore, master key from env PSM_KEYSTORE_KEY, then exit")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [command]\n", os.Args[0])
+		flag.PrintDefaults()
+		cli.Usage(flag.CommandLine.Output())
+	}
+	flag.Parse()
+
+	if *keystoreSet != "" {
+		if err := setKeystoreSecret(*profile, *keystoreSet); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	args := flag.Args()
+	command := len(args) > 0 && args[0] != "serve"
 
-	logger := logs.NewLogrus(condutils.Or(os.Getenv("SYSTEM_NAME"), "SYSTEM").(string))
+	provider := config.NewLayeredProvider(*profile)
+	if command {
+		// secret only used by server, command runnable without it
+		provider = config.NewUnresolvedLayeredProvider(*profile)
+	}
+	errConfig := provider.GetConfig(configFile)
+	if *printConfig {
+		if errConfig != nil {
+			fmt.Fprintln(os.Stderr, errConfig)
+			os.Exit(1)
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		enc.Encode(secrets.MaskConfig(provider.Config()))
+		return
+	}
+	conf, errDecode := config.NewConfig(provider.Config())
+	conf.Apply()
+
+	// admin command run against same storage then exit, server started by `serve` or no command
+	if command {
+		if e, ok := condutils.Ors(errConfig, errDecode).(error); ok && e != nil {
+			fmt.Fprintln(os.Stderr, e)
+			os.Exit(1)
+		}
+		os.Exit(runCommand(conf, args))
+	}
+
+	logger := logs.NewLogrus(conf.System.Name)
 	logger.Update()
 
 	defer func() {
@@ -44,71 +118,382 @@ func main() {
 		}
 	}()
 
-	viper := config.NewViperLocalProvider()
-
-	fmt.Println("configFile: ", configFile)
+	if e, ok := condutils.Ors(errConfig, errDecode).(error); ok && e != nil {
+		logger.Fatal(e)
+	}
+	// every invalid key reported at once instead of panicking on first one read
+	if errValidate := conf.Validate(); errValidate != nil {
+		logger.Fatal(errValidate)
+	}
+	logger.Info(fmt.Sprintf("config loaded from %s, profile %q", configFile, *profile))
 
-	if errViper := viper.GetConfig(configFile); errViper != nil {
-		logger.Fatal(errViper)
+	spanExporter, errTracing := tracing.NewExporter(conf.Tracing)
+	if errTracing != nil {
+		logger.Fatal(errTracing)
 	}
+	tracer := tracing.NewTracer(tracing.ServiceName(conf.Tracing, conf.System.ServiceName), spanExporter)
+	tracing.SetDefault(tracer)
 
-	config := viper.Config()
-	server := router.NewEchoServerV2(config)
-	fileStorage := file.NewStorageFile(config)
+	server := router.NewEchoServerV2(conf)
+	fileStorage := conf.FileStorage.Path
 
 	validators := validatorRequest.NewValidator()
 
+	if errMigrate := migration.RunFloorMigration(file.NewFileSystem(fileStorage)); errMigrate != nil {
+		logger.Fatal(errMigrate)
+	}
+
+	handlers, errHandlers := newHandlers(conf, validators, fileStorage, server.Routes)
+	if errHandlers != nil {
+		logger.Fatal(errHandlers)
+	}
+
+	// first admin operator on fresh installation, password expected from environment rather than config file
+	if bootstrap := conf.Auth.Bootstrap; bootstrap.Password != "" {
+		if errBootstrap := handlers.auth.UsecaseAuth.EnsureBootstrapAdmin(bootstrap.Username, bootstrap.Password); errBootstrap != nil {
+			logger.Fatal(errBootstrap)
+		}
+	}
+	server.UseTokenCheck(handlers.auth.UsecaseAuth.IsTokenRevoked)
+	server.UseAPIKeyResolver(handlers.auth.ResolveApiKey())
+
+	// writes tracked so shutdown drain them instead of guessing how long they take
+	manager := lifecycle.New()
+	server.UseLifecycle(manager)
+
+	registerRoutes(server, handlers)
+
+	ecServer := server.GetServer()
+	ecServer.Use(middleware.CORS())
+
+	if *watchConfig {
+		watcher := watchConfigFiles(conf, *profile, server, handlers, logger)
+		manager.OnStop("config watcher", func(context.Context) error {
+			watcher.Stop()
+			return nil
+		})
+	}
+
+	manager.OnStop("http server", ecServer.Shutdown)
+	manager.OnFlush("storage", func(context.Context) error { return file.SyncStorage(fileStorage) })
+	manager.OnFlush("tracing", func(context.Context) error { return tracer.Shutdown() })
+
+	go func(addr string, server *echo.Echo) {
+		if startErr := server.Start(addr); startErr != nil && startErr != http.ErrServerClosed {
+			logger.Fatal(startErr)
+		}
+	}(conf.Server.Address(), ecServer)
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, lifecycle.Signals...)
+	sig := <-quit
+
+	timeout := conf.Server.ShutdownWait()
+	logger.Info(fmt.Sprintf("server get %v signal, waiting in-flight work up to %v", sig, timeout))
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	report := manager.Shutdown(ctx)
+	for _, work := range report.Abandoned {
+		logger.Warn("abandoned on shutdown: ", work)
+	}
+	for _, err := range report.Errors {
+		logger.Error(err)
+	}
+	logger.Info("Exiting")
+}
+
+// setKeystoreSecret store secret `key` read from stdin on keystore configured by `secret_store.keystore`.
+// Config read without resolving secret reference, secret stored may be the one still missing.
+func setKeystoreSecret(profile, key string) error {
+	provider := config.NewUnresolvedLayeredProvider(profile)
+	if err := provider.GetConfig(configFile); err != nil {
+		return err
+	}
+	conf, err := config.NewConfig(provider.Config())
+	if err != nil {
+		return err
+	}
+	ks, err := secrets.OpenKeystore(conf.SecretStore.Keystore, os.Getenv(secrets.EnvKeystoreKey))
+	if err != nil {
+		return err
+	}
+	value, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return err
+	}
+	ks.Set(key, strings.TrimRight(string(value), "\r\n"))
+	return ks.Save()
+}
+
+// watchConfigFiles reload reloadable part of config when its files change, applied on settings reading them.
+// Invalid config logged and running config kept.
+func watchConfigFiles(
+	conf *config.Config,
+	profile string,
+	server router.ServerV2,
+	h *appHandlers,
+	logger logs.ILog,
+) *config.Watcher {
+	watcher := config.NewWatcher(conf, config.Loader(configFile, profile))
+	// checked before swapped in, so reloaded config applied whole or not at all
+	watcher.OnCheck(server.CheckReload)
+	watcher.OnChange(func(change config.Change) {
+		if len(change.Pending) > 0 {
+			logger.Warn(fmt.Sprintf("config changed but applied on restart only: %s", strings.Join(change.Pending, ", ")))
+		}
+		if len(change.Keys) == 0 {
+			return
+		}
+		// only fallible step first, nothing else applied when it fail
+		if err := server.Reload(change.New); err != nil {
+			logger.Error(fmt.Errorf("reload config, not applied: %w", err))
+			return
+		}
+		change.New.ApplyReloadable(logger)
+		h.parking.Settings.Set(parkingUsecase.NewQueueConfig(change.New.Parking.Queue))
+		logger.Info(fmt.Sprintf("config reloaded: %s", strings.Join(change.Keys, ", ")))
+	})
+	watcher.OnError(func(err error) {
+		logger.Error(fmt.Errorf("reload config, running config kept: %w", err))
+	})
+	watcher.Watch(config.Files(configFile, profile)...)
+	return watcher
+}
+
+// runCommand run admin command `args` through usecases against storage of `conf`, exit code returned.
+func runCommand(conf *config.Config, args []string) int {
+	if err := conf.ValidateCommand(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if err := migration.RunFloorMigration(file.NewFileSystem(conf.FileStorage.Path)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	err := cli.NewApp(conf, validatorRequest.NewValidator()).Run(args)
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, cli.ErrUsage):
+		return 2
+	}
+	fmt.Fprintln(os.Stderr, err)
+	return 1
+}
+
+// appHandlers handlers of every route served
+type appHandlers struct {
+	parking    *parkingHandler.Handlers
+	parkingLot *parkingLotHandler.Handlers
+	vehicle    *vehicleHandler.Handlers
+	site       *siteHandler.Handlers
+	floor      *floorHandler.Handlers
+	auth       *authHandler.Handlers
+	auditLog   *auditLogHandler.Handlers
+	apiDoc     *apiDocHandler.Handlers
+	health     *healthHandler.Handlers
+}
+
+// newHandlers create handlers of every route on storage `fileStorage`, `routes` documented by API doc handlers
+func newHandlers(
+	config *config.Config,
+	validators validation.Validate,
+	fileStorage string,
+	routes func() []router.RouteInfo,
+) (*appHandlers, error) {
 	parkingHandler, parkingErr := parkingHandler.NewParkingHandlers(config, validators, fileStorage)
 	parkingLotHandler, parkingLotErr := parkingLotHandler.NewParkingLotHandlers(config, validators, fileStorage)
 	vehicleHandler, VehicleErr := vehicleHandler.NewVehicleHandlers(config, validators, fileStorage)
+	siteHandler, siteErr := siteHandler.NewSiteHandlers(config, validators, fileStorage)
+	floorHandler, floorErr := floorHandler.NewFloorHandlers(config, validators, fileStorage)
+	authHandler, authErr := authHandler.NewAuthHandlers(config, validators, fileStorage)
+	auditLogHandler, auditLogErr := auditLogHandler.NewAuditLogHandlers(config, validators, fileStorage)
+	apiDocHandler, apiDocErr := apiDocHandler.NewApiDocHandlers(config, routes)
+	healthHandler, healthErr := healthHandler.NewHealthHandlers(config, fileStorage)
 
 	if e, ok := condutils.Ors(
 		parkingErr,
 		parkingLotErr,
 		VehicleErr,
+		siteErr,
+		floorErr,
+		authErr,
+		auditLogErr,
+		apiDocErr,
+		healthErr,
 	).(error); ok && e != nil {
-		logger.Fatal(e)
+		return nil, e
+	}
+
+	return &appHandlers{
+		parking:    parkingHandler,
+		parkingLot: parkingLotHandler,
+		vehicle:    vehicleHandler,
+		site:       siteHandler,
+		floor:      floorHandler,
+		auth:       authHandler,
+		auditLog:   auditLogHandler,
+		apiDoc:     apiDocHandler,
+		health:     healthHandler,
+	}, nil
+}
+
+// registerRoutes register every route of the app on `server`
+func registerRoutes(server router.ServerV2, h *appHandlers) {
+	// siteScopedAuth register route for default site and for site given on route `/sites/:siteId`,
+	// route must be authenticated and allowed by `permission`, caller only allowed on its own site
+	siteScopedAuth := func(method, path string, permission router.Permission, handler func(interface{}) error) {
+		server.HandleAuthWith(method, "/api/v1/parking-management"+path, permission, handler)
+		server.HandleAuthWith(method, "/api/v1/parking-management/sites/:siteId"+path, permission, handler)
+	}
+	// siteScopedAuthV2 same as siteScopedAuth on API v2
+	siteScopedAuthV2 := func(method, path string, permission router.Permission, handler func(interface{}) error) {
+		server.HandleAuthWith(method, "/api/v2/parking-management"+path, permission, handler)
+		server.HandleAuthWith(method, "/api/v2/parking-management/sites/:siteId"+path, permission, handler)
+	}
+	// site data readable by any authenticated caller, gate hardware included
+	siteReader := router.Permission{Scopes: []string{constant.ScopeReadOnly, constant.ScopeParkingIn, constant.ScopeParkingOut}}
+	adminOnly := router.Permission{Roles: []string{constant.RoleAdmin}}
+	adminOrOperator := router.Permission{Roles: []string{constant.RoleAdmin, constant.RoleOperator}}
+	// audit trail and reports read by role only, never by API key of gate hardware
+	adminOrAuditor := router.Permission{Roles: []string{constant.RoleAdmin, constant.RoleAuditor}}
+	gateIn := router.Permission{
+		Roles:  []string{constant.RoleAdmin, constant.RoleOperator, constant.RoleGateDevice},
+		Scopes: []string{constant.ScopeParkingIn},
+	}
+	gateOut := router.Permission{
+		Roles:  []string{constant.RoleAdmin, constant.RoleOperator, constant.RoleGateDevice},
+		Scopes: []string{constant.ScopeParkingOut},
 	}
 
-	server.Handle("POST", "/api/v1/parking-management/parking-in", parkingHandler.SetParkingIn())
-	server.Handle("POST", "/api/v1/parking-management/parking-out", parkingHandler.SetParkingOut())
-	server.Handle("GET", "/api/v1/parking-management/get-parking-data", parkingHandler.GetParkingData())
-	server.Handle("GET", "/api/v1/parking-management/get-count-parking-data", parkingHandler.GetCountParkingData())
+	server.Handle("POST", "/api/v1/parking-management/auth/token", h.auth.IssueToken())
+	server.Handle("POST", "/api/v1/parking-management/auth/refresh", h.auth.RefreshToken())
+	server.HandleAuth("POST", "/api/v1/parking-management/auth/revoke", h.auth.RevokeToken())
+	server.HandleAuthWith("GET", "/api/v1/parking-management/auth/operators", adminOnly, h.auth.GetOperator())
+	server.HandleAuthWith("POST", "/api/v1/parking-management/auth/operator", adminOnly, h.auth.CreateOperator())
+	server.HandleAuthWith("PUT", "/api/v1/parking-management/auth/operator", adminOnly, h.auth.UpdateOperator())
+	server.HandleAuthWith("DELETE", "/api/v1/parking-management/auth/operator", adminOnly, h.auth.DeleteOperator())
+	server.HandleAuthWith("GET", "/api/v1/parking-management/auth/gate-devices", adminOnly, h.auth.GetGateDevice())
+	server.HandleAuthWith("POST", "/api/v1/parking-management/auth/gate-device", adminOnly, h.auth.CreateGateDevice())
+	server.HandleAuthWith("DELETE", "/api/v1/parking-management/auth/gate-device", adminOnly, h.auth.DeleteGateDevice())
+	server.HandleAuthWith("GET", "/api/v1/parking-management/auth/api-keys", adminOnly, h.auth.GetApiKey())
+	server.HandleAuthWith("POST", "/api/v1/parking-management/auth/api-key", adminOnly, h.auth.CreateApiKey())
+	server.HandleAuthWith("POST", "/api/v1/parking-management/auth/api-key/rotate", adminOnly, h.auth.RotateApiKey())
+	server.HandleAuthWith("DELETE", "/api/v1/parking-management/auth/api-key", adminOnly, h.auth.RevokeApiKey())
 
-	server.Handle("GET", "/api/v1/parking-management/parking-lot/:id", parkingLotHandler.GetDetailParkingLot())
-	server.Handle("GET", "/api/v1/parking-management/parking-lots", parkingLotHandler.GetParkingLot())
-	server.Handle("POST", "/api/v1/parking-management/parking-lot", parkingLotHandler.CreateParkingLot())
-	server.Handle("PUT", "/api/v1/parking-management/parking-lot", parkingLotHandler.UpdateParkingLot())
-	server.Handle("DELETE", "/api/v1/parking-management/parking-lot", parkingLotHandler.DeleteParkingLot())
+	siteScopedAuth("POST", "/parking-in", gateIn, h.parking.SetParkingIn())
+	siteScopedAuth("POST", "/parking-out", gateOut, h.parking.SetParkingOut())
+	siteScopedAuth("GET", "/get-parking-data", siteReader, h.parking.GetParkingData())
+	siteScopedAuth("GET", "/get-count-parking-data", siteReader, h.parking.GetCountParkingData())
+	siteScopedAuth("GET", "/parking-queue", siteReader, h.parking.GetParkingQueue())
+	siteScopedAuth("DELETE", "/parking-queue", gateIn, h.parking.CancelParkingQueue())
 
-	server.Handle("GET", "/api/v1/parking-management/vehicle", vehicleHandler.GetDetailVehicle())
-	server.Handle("GET", "/api/v1/parking-management/vehicles", vehicleHandler.GetVehicle())
-	server.Handle("POST", "/api/v1/parking-management/vehicle", vehicleHandler.CreateVehicle())
-	server.Handle("PUT", "/api/v1/parking-management/vehicle", vehicleHandler.UpdateVehicle())
-	server.Handle("DELETE", "/api/v1/parking-management/vehicles", vehicleHandler.DeleteVehicle())
+	siteScopedAuth("GET", "/parking-lot/:id", siteReader, h.parkingLot.GetDetailParkingLot())
+	siteScopedAuth("GET", "/parking-lots", siteReader, h.parkingLot.GetParkingLot())
+	siteScopedAuth("POST", "/parking-lot", adminOnly, h.parkingLot.CreateParkingLot())
+	siteScopedAuth("PUT", "/parking-lot", adminOnly, h.parkingLot.UpdateParkingLot())
+	siteScopedAuth("DELETE", "/parking-lot", adminOnly, h.parkingLot.DeleteParkingLot())
+	siteScopedAuth("PUT", "/parking-lot/status", adminOrOperator, h.parkingLot.SetParkingLotStatus())
+	siteScopedAuth("POST", "/parking-lots/bulk", adminOnly, h.parkingLot.CreateBulkParkingLot())
+	siteScopedAuth("PUT", "/parking-lots/bulk", adminOnly, h.parkingLot.UpdateBulkParkingLot())
+	siteScopedAuth("DELETE", "/parking-lots/bulk", adminOnly, h.parkingLot.DeleteBulkParkingLot())
 
-	ecServer := server.GetServer()
-	ecServer.Use(middleware.CORS())
+	siteScopedAuth("GET", "/maintenance-window/:id", siteReader, h.parkingLot.GetDetailMaintenanceWindow())
+	siteScopedAuth("GET", "/maintenance-windows", siteReader, h.parkingLot.GetMaintenanceWindow())
+	siteScopedAuth("POST", "/maintenance-window", adminOrOperator, h.parkingLot.CreateMaintenanceWindow())
+	siteScopedAuth("DELETE", "/maintenance-window", adminOrOperator, h.parkingLot.DeleteMaintenanceWindow())
 
-	go func(addr string, server *echo.Echo) {
-		if startErr := server.Start(addr); startErr != nil {
-			logger.Fatal(startErr)
-		}
-	}(condutils.Or(":8080", viper.Config()["server"]).(string), ecServer)
-	quit := make(chan os.Signal)
-	signal.Notify(quit, os.Interrupt)
-	<-quit
+	siteScopedAuth("GET", "/floor/:id", siteReader, h.floor.GetDetailFloor())
+	siteScopedAuth("GET", "/floors", siteReader, h.floor.GetFloor())
+	siteScopedAuth("POST", "/floor", adminOnly, h.floor.CreateFloor())
+	siteScopedAuth("PUT", "/floor", adminOnly, h.floor.UpdateFloor())
+	siteScopedAuth("PUT", "/floor/status", adminOrOperator, h.floor.SetFloorStatus())
+	siteScopedAuth("DELETE", "/floor", adminOnly, h.floor.DeleteFloor())
+	siteScopedAuth("GET", "/reports/floors", adminOrAuditor, h.floor.GetFloorOccupancy())
 
-	defer logger.Info("server get interrupt signal")
+	siteScopedAuth("GET", "/zone/:id", siteReader, h.floor.GetDetailZone())
+	siteScopedAuth("GET", "/zones", siteReader, h.floor.GetZone())
+	siteScopedAuth("POST", "/zone", adminOnly, h.floor.CreateZone())
+	siteScopedAuth("PUT", "/zone", adminOnly, h.floor.UpdateZone())
+	siteScopedAuth("DELETE", "/zone", adminOnly, h.floor.DeleteZone())
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+	siteScopedAuth("GET", "/vehicle/:id", siteReader, h.vehicle.GetDetailVehicle())
+	siteScopedAuth("GET", "/vehicles", siteReader, h.vehicle.GetVehicle())
+	siteScopedAuth("POST", "/vehicle", adminOnly, h.vehicle.CreateVehicle())
+	siteScopedAuth("PUT", "/vehicle", adminOnly, h.vehicle.UpdateVehicle())
+	siteScopedAuth("DELETE", "/vehicles", adminOnly, h.vehicle.DeleteVehicle())
 
-	if err := ecServer.Shutdown(ctx); err != nil {
-		logger.Fatal(err)
-	}
+	server.HandleAuthWith("GET", "/api/v1/parking-management/site/:id", siteReader, h.site.GetDetailSite())
+	server.HandleAuthWith("GET", "/api/v1/parking-management/sites", siteReader, h.site.GetSite())
+	server.HandleAuthWith("POST", "/api/v1/parking-management/site", adminOnly, h.site.CreateSite())
+	server.HandleAuthWith("PUT", "/api/v1/parking-management/site", adminOnly, h.site.UpdateSite())
+	server.HandleAuthWith("DELETE", "/api/v1/parking-management/site", adminOnly, h.site.DeleteSite())
+	server.HandleAuthWith("GET", "/api/v1/parking-management/reports/sites", adminOrAuditor, h.site.GetSiteReports())
 
-	logger.Info("wait more 5 second for async write to db")
-	time.Sleep(time.Second * 5)
-	logger.Info("Exiting")
+	siteScopedAuth("GET", "/audit", adminOrAuditor, h.auditLog.GetAuditLog())
+	server.HandleAuthWith("GET", "/api/v1/parking-management/audit/verify", adminOrAuditor, h.auditLog.VerifyAuditLog())
+
+	// API v2 served by same usecases as v1, authentication shared so auth routes only on v1
+	siteScopedAuthV2("POST", "/parking-in", gateIn, h.parking.SetParkingInV2())
+	siteScopedAuthV2("POST", "/parking-out", gateOut, h.parking.SetParkingOutV2())
+	siteScopedAuthV2("GET", "/get-parking-data", siteReader, h.parking.GetParkingDataV2())
+	siteScopedAuthV2("GET", "/get-count-parking-data", siteReader, h.parking.GetCountParkingDataV2())
+	siteScopedAuthV2("GET", "/parking-queue", siteReader, h.parking.GetParkingQueueV2())
+	siteScopedAuthV2("DELETE", "/parking-queue", gateIn, h.parking.CancelParkingQueueV2())
+
+	siteScopedAuthV2("GET", "/parking-lot/:id", siteReader, h.parkingLot.GetDetailParkingLotV2())
+	siteScopedAuthV2("GET", "/parking-lots", siteReader, h.parkingLot.GetParkingLotV2())
+	siteScopedAuthV2("POST", "/parking-lot", adminOnly, h.parkingLot.CreateParkingLotV2())
+	siteScopedAuthV2("PUT", "/parking-lot", adminOnly, h.parkingLot.UpdateParkingLotV2())
+	siteScopedAuthV2("DELETE", "/parking-lot", adminOnly, h.parkingLot.DeleteParkingLotV2())
+	siteScopedAuthV2("PUT", "/parking-lot/status", adminOrOperator, h.parkingLot.SetParkingLotStatusV2())
+	siteScopedAuthV2("POST", "/parking-lots/bulk", adminOnly, h.parkingLot.CreateBulkParkingLotV2())
+	siteScopedAuthV2("PUT", "/parking-lots/bulk", adminOnly, h.parkingLot.UpdateBulkParkingLotV2())
+	siteScopedAuthV2("DELETE", "/parking-lots/bulk", adminOnly, h.parkingLot.DeleteBulkParkingLotV2())
+
+	siteScopedAuthV2("GET", "/maintenance-window/:id", siteReader, h.parkingLot.GetDetailMaintenanceWindowV2())
+	siteScopedAuthV2("GET", "/maintenance-windows", siteReader, h.parkingLot.GetMaintenanceWindowV2())
+	siteScopedAuthV2("POST", "/maintenance-window", adminOrOperator, h.parkingLot.CreateMaintenanceWindowV2())
+	siteScopedAuthV2("DELETE", "/maintenance-window", adminOrOperator, h.parkingLot.DeleteMaintenanceWindowV2())
+
+	siteScopedAuthV2("GET", "/floor/:id", siteReader, h.floor.GetDetailFloorV2())
+	siteScopedAuthV2("GET", "/floors", siteReader, h.floor.GetFloorV2())
+	siteScopedAuthV2("POST", "/floor", adminOnly, h.floor.CreateFloorV2())
+	siteScopedAuthV2("PUT", "/floor", adminOnly, h.floor.UpdateFloorV2())
+	siteScopedAuthV2("PUT", "/floor/status", adminOrOperator, h.floor.SetFloorStatusV2())
+	siteScopedAuthV2("DELETE", "/floor", adminOnly, h.floor.DeleteFloorV2())
+	siteScopedAuthV2("GET", "/reports/floors", adminOrAuditor, h.floor.GetFloorOccupancyV2())
+
+	siteScopedAuthV2("GET", "/zone/:id", siteReader, h.floor.GetDetailZoneV2())
+	siteScopedAuthV2("GET", "/zones", siteReader, h.floor.GetZoneV2())
+	siteScopedAuthV2("POST", "/zone", adminOnly, h.floor.CreateZoneV2())
+	siteScopedAuthV2("PUT", "/zone", adminOnly, h.floor.UpdateZoneV2())
+	siteScopedAuthV2("DELETE", "/zone", adminOnly, h.floor.DeleteZoneV2())
+
+	siteScopedAuthV2("GET", "/vehicle/:id", siteReader, h.vehicle.GetDetailVehicleV2())
+	siteScopedAuthV2("GET", "/vehicles", siteReader, h.vehicle.GetVehicleV2())
+	siteScopedAuthV2("POST", "/vehicle", adminOnly, h.vehicle.CreateVehicleV2())
+	siteScopedAuthV2("PUT", "/vehicle", adminOnly, h.vehicle.UpdateVehicleV2())
+	siteScopedAuthV2("DELETE", "/vehicles", adminOnly, h.vehicle.DeleteVehicleV2())
+
+	server.HandleAuthWith("GET", "/api/v2/parking-management/site/:id", siteReader, h.site.GetDetailSiteV2())
+	server.HandleAuthWith("GET", "/api/v2/parking-management/sites", siteReader, h.site.GetSiteV2())
+	server.HandleAuthWith("POST", "/api/v2/parking-management/site", adminOnly, h.site.CreateSiteV2())
+	server.HandleAuthWith("PUT", "/api/v2/parking-management/site", adminOnly, h.site.UpdateSiteV2())
+	server.HandleAuthWith("DELETE", "/api/v2/parking-management/site", adminOnly, h.site.DeleteSiteV2())
+	server.HandleAuthWith("GET", "/api/v2/parking-management/reports/sites", adminOrAuditor, h.site.GetSiteReportsV2())
+
+	siteScopedAuthV2("GET", "/audit", adminOrAuditor, h.auditLog.GetAuditLogV2())
+	server.HandleAuthWith("GET", "/api/v2/parking-management/audit/verify", adminOrAuditor, h.auditLog.VerifyAuditLogV2())
+
+	server.Handle("GET", apiDocHandler.SpecPath, h.apiDoc.GetOpenAPI())
+	server.Handle("GET", apiDocHandler.UIPath, h.apiDoc.GetSwaggerUI())
+
+	// probes and metrics outside of API prefix, skipped by jwt middleware through `CreateSkippedHandler`
+	server.Handle("GET", healthHandler.HealthzPath, h.health.GetHealthz())
+	server.Handle("GET", healthHandler.ReadyzPath, h.health.GetReadyz())
+	server.Handle("GET", healthHandler.VersionPath, h.health.GetVersion())
+	server.Handle("GET", healthHandler.MetricsPath, h.health.GetMetrics())
 }
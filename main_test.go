@@ -0,0 +1,93 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/mhaikalla/parking-service-management-library/components/handlers/apidoc"
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
+	"github.com/mhaikalla/parking-service-management-library/pkg/router"
+	"github.com/mhaikalla/parking-service-management-library/pkg/secrets"
+	validatorRequest "github.com/mhaikalla/parking-service-management-library/pkg/validator"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MainTestSuite struct {
+	suite.Suite
+	server router.ServerV2
+}
+
+func (s *MainTestSuite) SetupTest() {
+	// shipped config reference jwt key, provided as mounted secret would be
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	s.Require().NoError(err)
+	s.Require().NoError(os.Setenv(secrets.EnvName("jwt.key"), string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))))
+
+	provider := config.NewLayeredProvider("")
+	s.Require().NoError(provider.GetConfig(configFile))
+	conf, err := config.NewConfig(provider.Config())
+	s.Require().NoError(err)
+	s.Require().NoError(conf.Validate(), "shipped config valid")
+	conf.FileStorage.Path = s.T().TempDir() + "/"
+
+	s.server = router.NewEchoServerV2(conf)
+	handlers, err := newHandlers(conf, validatorRequest.NewValidator(), conf.FileStorage.Path, s.server.Routes)
+	s.Require().NoError(err)
+	registerRoutes(s.server, handlers)
+}
+
+func (s *MainTestSuite) TearDownTest() {
+	os.Unsetenv(secrets.EnvName("jwt.key"))
+}
+
+func (s *MainTestSuite) TestEveryRouteDocumented() {
+	doc, missing := apidoc.Generate(apidoc.NewGenerator(), s.server.Routes())
+
+	for _, r := range missing {
+		s.Failf("route has no OpenAPI schema", "%s %s must be added to apidoc operations", r.Method, r.Path)
+	}
+	s.NotEmpty(doc.Paths)
+}
+
+func (s *MainTestSuite) TestSiteScopedRoutesAuthenticated() {
+	for _, r := range s.server.Routes() {
+		if strings.Contains(r.Path, "/site") {
+			s.Truef(r.Auth, "%s %s must be authenticated so caller bound to its site", r.Method, r.Path)
+		}
+	}
+}
+
+func (s *MainTestSuite) TestAuditAndReportsRoleOnly() {
+	for _, r := range s.server.Routes() {
+		if strings.Contains(r.Path, "/audit") || strings.Contains(r.Path, "/reports/") {
+			s.Truef(r.Auth, "%s %s must be authenticated", r.Method, r.Path)
+			s.Emptyf(r.Permission.Scopes, "%s %s must not be readable by API key", r.Method, r.Path)
+		}
+	}
+}
+
+func (s *MainTestSuite) TestCommandWithoutSecret() {
+	// command read shipped config as main does, secret reference kept unresolved
+	s.Require().NoError(os.Unsetenv(secrets.EnvName("jwt.key")))
+	provider := config.NewUnresolvedLayeredProvider("")
+	s.Require().NoError(provider.GetConfig(configFile))
+	conf, err := config.NewConfig(provider.Config())
+	s.Require().NoError(err)
+	conf.FileStorage.Path = s.T().TempDir() + "/"
+
+	s.Equal(0, runCommand(conf, []string{"vehicle", "create", "-name", "SUV", "-type", "SUV", "-first-hour-price", "5000", "-price-per-hour-percent", "10"}),
+		"command runnable without jwt secret")
+	s.Equal(0, runCommand(conf, []string{"vehicle", "list"}))
+}
+
+func TestMainSuite(t *testing.T) {
+	suite.Run(t, new(MainTestSuite))
+}
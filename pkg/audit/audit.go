@@ -0,0 +1,117 @@
+// Package audit keep append-only trail of mutations, every entry chained to previous one by its hash
+// so edited or removed entry can be detected.
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// Entry one mutation recorded on audit trail.
+type Entry struct {
+	Seq       int             `json:"seq"`
+	SiteId    int             `json:"site_id"`
+	Actor     string          `json:"actor"`
+	RequestId string          `json:"request_id"`
+	Action    string          `json:"action"`
+	Entity    string          `json:"entity"`
+	EntityId  string          `json:"entity_id"`
+	Before    json.RawMessage `json:"before,omitempty"`
+	After     json.RawMessage `json:"after,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+	PrevHash  string          `json:"prev_hash"`
+	Hash      string          `json:"hash"`
+}
+
+// ComputeHash hex sha256 of entry content including hash of previous entry, `Hash` itself excluded.
+func (e Entry) ComputeHash() string {
+	e.Hash = ""
+	buff, _ := json.Marshal(e)
+	sum := sha256.Sum256(buff)
+	return hex.EncodeToString(sum[:])
+}
+
+// Filter criteria of `Trail.Query`, empty field not filtered.
+type Filter struct {
+	SiteId    *int
+	Actor     string
+	RequestId string
+	Action    string
+	Entity    string
+	EntityId  string
+	From      time.Time
+	To        time.Time
+	Limit     int
+	Offset    int
+}
+
+// Match check whether `e` satisfy filter.
+func (f Filter) Match(e Entry) bool {
+	switch {
+	case f.SiteId != nil && e.SiteId != *f.SiteId:
+		return false
+	case f.Actor != "" && e.Actor != f.Actor:
+		return false
+	case f.RequestId != "" && e.RequestId != f.RequestId:
+		return false
+	case f.Action != "" && e.Action != f.Action:
+		return false
+	case f.Entity != "" && e.Entity != f.Entity:
+		return false
+	case f.EntityId != "" && e.EntityId != f.EntityId:
+		return false
+	case !f.From.IsZero() && e.CreatedAt.Before(f.From):
+		return false
+	case !f.To.IsZero() && !e.CreatedAt.Before(f.To):
+		return false
+	}
+	return true
+}
+
+// Trail append-only audit storage, implementation must be safe for concurrent use.
+type Trail interface {
+	// Append chain `e` to last entry and store it, returning entry as stored.
+	Append(e Entry) (Entry, error)
+	// Query return entries matching `f` newest first, along with total matched before paging.
+	Query(f Filter) ([]Entry, int, error)
+	// Verify check hash chain of whole trail, returning seq of first broken entry or 0 when intact.
+	Verify() (int, error)
+}
+
+// Chain fill sequence and hashes of `e` so it follow `prev`, nil `prev` start a new chain.
+func Chain(prev *Entry, e Entry) Entry {
+	e.Seq = 1
+	e.PrevHash = ""
+	if prev != nil {
+		e.Seq = prev.Seq + 1
+		e.PrevHash = prev.Hash
+	}
+	e.Hash = e.ComputeHash()
+	return e
+}
+
+// VerifyChain return seq of first entry whose hash or link to previous entry does not match, 0 when intact.
+func VerifyChain(entries []Entry) int {
+	prevHash := ""
+	for i, e := range entries {
+		if e.Seq != i+1 || e.PrevHash != prevHash || e.Hash != e.ComputeHash() {
+			return i + 1
+		}
+		prevHash = e.Hash
+	}
+	return 0
+}
+
+// Snapshot marshal entity state for `Entry.Before` / `Entry.After`, nil kept empty.
+func Snapshot(v interface{}) json.RawMessage {
+	if v == nil {
+		return nil
+	}
+	buff, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return buff
+}
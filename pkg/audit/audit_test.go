@@ -0,0 +1,105 @@
+package audit
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AuditTestSuite struct {
+	suite.Suite
+	path  string
+	trail *FileTrail
+	now   time.Time
+}
+
+func (s *AuditTestSuite) SetupTest() {
+	s.path = s.T().TempDir() + "/"
+	s.trail = NewFileTrail(file.NewFileSystem(s.path))
+	s.now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *AuditTestSuite) appendEntries() {
+	type lot struct {
+		Name string `json:"name"`
+	}
+	for i, action := range []string{"create", "update", "delete"} {
+		_, err := s.trail.Append(Entry{
+			SiteId:    i % 2,
+			Actor:     "operator:1",
+			Action:    action,
+			Entity:    "parking_lot",
+			EntityId:  "1",
+			Before:    Snapshot(lot{Name: "A<1>"}),
+			After:     Snapshot(lot{Name: "A&2"}),
+			CreatedAt: s.now.Add(time.Duration(i) * time.Minute),
+		})
+		s.NoError(err)
+	}
+}
+
+func (s *AuditTestSuite) TestAppendChain() {
+	s.appendEntries()
+
+	entries, total, err := s.trail.Query(Filter{})
+	s.NoError(err)
+	s.Equal(3, total)
+	s.Equal(3, entries[0].Seq, "newest entry must be first")
+	s.Equal(entries[1].Hash, entries[0].PrevHash, "entry must be chained to previous one")
+	s.Equal("", entries[2].PrevHash, "first entry start the chain")
+
+	broken, err := s.trail.Verify()
+	s.NoError(err)
+	s.Equal(0, broken, "untouched trail must be intact")
+}
+
+func (s *AuditTestSuite) TestQueryFilter() {
+	s.appendEntries()
+
+	site := 1
+	entries, total, _ := s.trail.Query(Filter{SiteId: &site})
+	s.Equal(1, total)
+	s.Equal("update", entries[0].Action)
+
+	entries, total, _ = s.trail.Query(Filter{Action: "delete"})
+	s.Equal(1, total)
+	s.Equal(3, entries[0].Seq)
+
+	_, total, _ = s.trail.Query(Filter{From: s.now.Add(time.Minute), To: s.now.Add(2 * time.Minute)})
+	s.Equal(1, total, "from inclusive, to exclusive")
+
+	entries, total, _ = s.trail.Query(Filter{Offset: 1, Limit: 1})
+	s.Equal(3, total, "total counted before paging")
+	s.Len(entries, 1)
+	s.Equal(2, entries[0].Seq)
+}
+
+func (s *AuditTestSuite) TestVerifyDetectTampering() {
+	s.appendEntries()
+
+	entries := []Entry{}
+	buff, _ := os.ReadFile(s.path + TableName + ".json")
+	s.NoError(json.Unmarshal(buff, &entries))
+	entries[1].Actor = "operator:2"
+	buff, _ = json.Marshal(entries)
+	s.NoError(os.WriteFile(s.path+TableName+".json", buff, 0644))
+
+	broken, err := s.trail.Verify()
+	s.NoError(err)
+	s.Equal(2, broken, "edited entry must be reported")
+
+	s.Equal(2, VerifyChain([]Entry{entries[0], entries[2]}), "removed entry must be reported")
+}
+
+func (s *AuditTestSuite) TestForStorageShared() {
+	s.Same(ForStorage(s.path), ForStorage(s.path), "same storage must share trail")
+}
+
+func TestAuditSuite(t *testing.T) {
+	suite.Run(t, new(AuditTestSuite))
+}
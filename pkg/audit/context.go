@@ -0,0 +1,50 @@
+package audit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+)
+
+const (
+	// ActorAnonymous actor of mutation done on route without authentication.
+	ActorAnonymous = "anonymous"
+	// ActorSystem actor of mutation done outside of request, eg: bootstrap on startup.
+	ActorSystem = "system"
+)
+
+// ActorOf caller of `bc`, token subject for both operator token and API key.
+func ActorOf(bc contexts.BearerContext) string {
+	if bc.SideLoad.BearerData.Subject != "" {
+		return bc.SideLoad.BearerData.Subject
+	}
+	if bc.Context == nil {
+		return ActorSystem
+	}
+	return ActorAnonymous
+}
+
+// Record append mutation made by caller of `bc` to `trail`, nil trail record nothing.
+// Failure only logged since mutation itself already saved.
+func Record(trail Trail, bc contexts.BearerContext, action, entity string, entityId interface{}, before, after interface{}) {
+	if trail == nil {
+		return
+	}
+	_, end := bc.StartSpan("audit.Record")
+	defer end()
+	_, err := trail.Append(Entry{
+		SiteId:    bc.GetSiteID(),
+		Actor:     ActorOf(bc),
+		RequestId: bc.GetRequestID(),
+		Action:    action,
+		Entity:    entity,
+		EntityId:  fmt.Sprint(entityId),
+		Before:    Snapshot(before),
+		After:     Snapshot(after),
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		bc.GetLogger().Error("failed to append audit trail: ", err)
+	}
+}
@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// TableName json table entries stored on.
+const TableName = "audit_log"
+
+// FileTrail keep entries on json table of storage layer.
+type FileTrail struct {
+	mu sync.Mutex
+	fs file.IFileSystem
+}
+
+var (
+	storageTrails   = map[string]*FileTrail{}
+	storageTrailsMu sync.Mutex
+)
+
+// NewFileTrail create trail on `fs`.
+func NewFileTrail(fs file.IFileSystem) *FileTrail {
+	return &FileTrail{fs: fs}
+}
+
+// ForStorage return trail of storage directory `path`, shared by every caller so appends on same table
+// never break the chain.
+func ForStorage(path string) *FileTrail {
+	storageTrailsMu.Lock()
+	defer storageTrailsMu.Unlock()
+
+	if t, ok := storageTrails[path]; ok {
+		return t
+	}
+	t := NewFileTrail(file.NewFileSystem(path))
+	storageTrails[path] = t
+	return t
+}
+
+// Append chain `e` to last entry and store it.
+func (t *FileTrail) Append(e Entry) (Entry, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	entries, err := t.load()
+	if err != nil {
+		return e, err
+	}
+	var prev *Entry
+	if len(entries) > 0 {
+		prev = &entries[len(entries)-1]
+	}
+	e = Chain(prev, e)
+	if _, err := t.fs.SaveData(TableName, append(entries, e)); err != nil {
+		return e, err
+	}
+	return e, nil
+}
+
+// Query return entries matching `f` newest first.
+func (t *FileTrail) Query(f Filter) ([]Entry, int, error) {
+	t.mu.Lock()
+	entries, err := t.load()
+	t.mu.Unlock()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	matched := []Entry{}
+	for i := len(entries) - 1; i >= 0; i-- {
+		if f.Match(entries[i]) {
+			matched = append(matched, entries[i])
+		}
+	}
+	total := len(matched)
+	if f.Offset >= total {
+		return []Entry{}, total, nil
+	}
+	matched = matched[f.Offset:]
+	if f.Limit > 0 && f.Limit < len(matched) {
+		matched = matched[:f.Limit]
+	}
+	return matched, total, nil
+}
+
+// Verify check hash chain of whole table.
+func (t *FileTrail) Verify() (int, error) {
+	t.mu.Lock()
+	entries, err := t.load()
+	t.mu.Unlock()
+	if err != nil {
+		return 0, err
+	}
+	return VerifyChain(entries), nil
+}
+
+func (t *FileTrail) load() ([]Entry, error) {
+	entries := []Entry{}
+	if !t.fs.IsFileExisting(TableName) {
+		if _, err := t.fs.CreateFile(TableName); err != nil {
+			return nil, err
+		}
+		return entries, nil
+	}
+	buff, err := t.fs.LoadFile(TableName)
+	if err != nil {
+		return nil, err
+	}
+	if len(buff) == 0 {
+		return entries, nil
+	}
+	if err := json.Unmarshal(buff, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
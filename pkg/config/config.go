@@ -0,0 +1,345 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/crypts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/jwt"
+	"github.com/mhaikalla/parking-service-management-library/pkg/payloadhooks"
+	"github.com/mhaikalla/parking-service-management-library/pkg/ratelimit"
+	"github.com/mhaikalla/parking-service-management-library/pkg/tracing"
+
+	validation "github.com/go-playground/validator/v10"
+	"github.com/spf13/viper"
+)
+
+// Config typed config of service, built by NewConfig from entries read by IConfig provider and checked by Validate.
+type Config struct {
+	System struct {
+		Name        string `mapstructure:"name" validate:"required"`
+		ServiceName string `mapstructure:"service_name" validate:"required"`
+	} `mapstructure:"system"`
+
+	Log struct {
+		Level  string `mapstructure:"level" validate:"omitempty,oneof=trace debug info warn warning error fatal panic"`
+		Format string `mapstructure:"format" validate:"omitempty,oneof=text json"`
+	} `mapstructure:"log"`
+
+	Errors struct {
+		DebugLocation  bool   `mapstructure:"debug_location"`
+		MaskingMessage bool   `mapstructure:"masking_message"`
+		TitleID        string `mapstructure:"title_id"`
+		DescriptionID  string `mapstructure:"description_id"`
+		TitleEN        string `mapstructure:"title_en"`
+		DescriptionEN  string `mapstructure:"description_en"`
+	} `mapstructure:"errors"`
+
+	PayloadCrypto struct {
+		Enable        bool   `mapstructure:"enable"`
+		Strict        string `mapstructure:"strict"`
+		Key           string `mapstructure:"key"`
+		MinAppVersion string `mapstructure:"min_app_version"`
+	} `mapstructure:"payload_crypto"`
+
+	Crypto struct {
+		MemberCode                 bool   `mapstructure:"member_code"`
+		ServiceCode                bool   `mapstructure:"service_code"`
+		ItemSaltKey                string `mapstructure:"item_salt_key"`
+		ServiceCodeStrictLocations string `mapstructure:"service_code_strict_locations"`
+		ServiceCodeExpiry          int    `mapstructure:"service_code_expiry" validate:"min=1"`
+	} `mapstructure:"crypto"`
+
+	Auth struct {
+		// Bootstrap first admin operator created on fresh installation when password set.
+		Bootstrap struct {
+			Username string `mapstructure:"username" validate:"required"`
+			Password string `mapstructure:"password"`
+		} `mapstructure:"bootstrap"`
+		Token TokenConfig `mapstructure:"token"`
+	} `mapstructure:"auth"`
+
+	Server      ServerConfig      `mapstructure:"server"`
+	Tracing     TracingConfig     `mapstructure:"tracing"`
+	FileStorage FileStorageConfig `mapstructure:"file_storage"`
+	// Database optional `gorm` entry, only checked by readiness probe.
+	Database    *DatabaseConfig   `mapstructure:"gorm"`
+	JWT         JWTConfig         `mapstructure:"jwt"`
+	Secrets     SecretsConfig     `mapstructure:"secrets"`
+	HTTPClient  HTTPClientConfig  `mapstructure:"http_client"`
+	Parking     ParkingConfig     `mapstructure:"parking"`
+	Idempotency IdempotencyConfig `mapstructure:"idempotency"`
+	SecretStore SecretStoreConfig `mapstructure:"secret_store"`
+	// RateLimit `ratelimit` entry as read, rules parsed by ratelimit.ParseConfig.
+	RateLimit map[string]interface{} `mapstructure:"ratelimit"`
+
+	// Raw entries as read, only for package still configured by map, eg: database.NewDBChecker.
+	Raw map[string]map[string]interface{} `mapstructure:"-"`
+}
+
+// ServerConfig `server` entry.
+type ServerConfig struct {
+	// Port used when Listen not set.
+	Port   int    `mapstructure:"port" validate:"min=0,max=65535"`
+	Listen string `mapstructure:"listen" validate:"omitempty,hostname_port"`
+	Debug  bool   `mapstructure:"debug"`
+	// ShutdownTimeout seconds shutdown wait in-flight writes before abandoning them.
+	ShutdownTimeout int      `mapstructure:"shutdown_timeout" validate:"min=0"`
+	Middlewares     []string `mapstructure:"middlewares" validate:"dive,oneof=metrics tracing attach_request_id log jwt auth ratelimit idempotency requestid recover"`
+}
+
+// Address address server listen on, `listen` when set otherwise all interfaces on `port`.
+func (s ServerConfig) Address() string {
+	if s.Listen != "" {
+		return s.Listen
+	}
+	return ":" + strconv.Itoa(s.Port)
+}
+
+// ShutdownWait shutdown deadline of in-flight work.
+func (s ServerConfig) ShutdownWait() time.Duration {
+	return time.Duration(s.ShutdownTimeout) * time.Second
+}
+
+// Uses whether middleware `name` listed.
+func (s ServerConfig) Uses(name string) bool {
+	for _, m := range s.Middlewares {
+		if m == name {
+			return true
+		}
+	}
+	return false
+}
+
+// TracingConfig `tracing` entry, read by tracing.NewExporter.
+type TracingConfig = tracing.Config
+
+// FileStorageConfig `file_storage` entry, storage of every json table.
+type FileStorageConfig struct {
+	// Driver storage driver, only `json` table files implemented.
+	Driver string `mapstructure:"driver" validate:"oneof=json"`
+	Path   string `mapstructure:"path" validate:"required"`
+}
+
+// DatabaseConfig `gorm` entry.
+type DatabaseConfig struct {
+	Dialect          string `mapstructure:"dialect" validate:"required"`
+	ConnectionString string `mapstructure:"connectionstring" validate:"required"`
+}
+
+// JWTConfig `jwt` entry, read by jwt.New.
+type JWTConfig = jwt.Config
+
+// SecretsConfig `secrets` entry, only secret read by this service typed.
+type SecretsConfig struct {
+	JWT jwt.Secret `mapstructure:"jwt"`
+}
+
+// HTTPClientConfig `http_client` entry.
+type HTTPClientConfig struct {
+	Upstreams struct {
+		// Timeout seconds upstream call wait response.
+		Timeout int `mapstructure:"timeout" validate:"min=1"`
+	} `mapstructure:"upstreams"`
+}
+
+// TokenConfig `auth.token` entry.
+type TokenConfig struct {
+	Issuer   string `mapstructure:"issuer" validate:"required"`
+	Audience string `mapstructure:"audience" validate:"required"`
+	// RefreshDuration seconds refresh token valid.
+	RefreshDuration int `mapstructure:"refresh_duration" validate:"min=1"`
+}
+
+// ParkingConfig `parking` entry.
+type ParkingConfig struct {
+	Queue ParkingQueueConfig `mapstructure:"queue"`
+}
+
+// ParkingQueueConfig `parking.queue` entry.
+type ParkingQueueConfig struct {
+	Enable bool `mapstructure:"enable"`
+	// HoldTimeout seconds a freed parking lot held for head of queue, overdue offer expired by next request
+	// reading queue of its site.
+	HoldTimeout int `mapstructure:"hold_timeout" validate:"min=1"`
+}
+
+// SecretStoreConfig `secret_store` entry, providers `${secret:<key>}` reference resolved from.
+type SecretStoreConfig struct {
+	// Providers tried in order, first having key win.
+	Providers []string `mapstructure:"providers" validate:"dive,oneof=env file keystore"`
+	// Dir directory of file provider, one file per key.
+	Dir string `mapstructure:"dir"`
+	// Keystore path of keystore provider, master key read from environment variable PSM_KEYSTORE_KEY.
+	Keystore string `mapstructure:"keystore"`
+}
+
+func (s *SecretStoreConfig) setDefault() {
+	if s.Providers == nil {
+		s.Providers = []string{"env", "file"}
+	}
+	if s.Dir == "" {
+		s.Dir = "/run/secrets"
+	}
+}
+
+// IdempotencyConfig `idempotency` entry.
+type IdempotencyConfig struct {
+	// TTL seconds first response replayed.
+	TTL     int      `mapstructure:"ttl" validate:"min=0"`
+	Methods []string `mapstructure:"methods" validate:"dive,required"`
+}
+
+// NewConfig typed config of `config` entries, default kept for key not set.
+// Error returned when value can not be read as its type, value itself checked by Validate.
+func NewConfig(config map[string]map[string]interface{}) (*Config, error) {
+	c := &Config{Raw: config}
+	c.System.Name = "SYSTEM"
+	c.System.ServiceName = "parking-service-management"
+	c.PayloadCrypto.Key = payloadhooks.PayloadCryptoKey
+	c.Crypto.ServiceCodeExpiry = crypts.DefaultServiceCodeExpiry
+	c.Auth.Bootstrap.Username = "admin"
+	c.Auth.Token = TokenConfig{
+		Issuer:          "parking-service-management",
+		Audience:        "parking-service-management",
+		RefreshDuration: 30 * 24 * 3600,
+	}
+	c.Server.Port = 8080
+	c.Server.ShutdownTimeout = 30
+	c.Tracing.Exporter = "none"
+	c.FileStorage.Driver = "json"
+	c.FileStorage.Path = "storage/"
+	c.HTTPClient.Upstreams.Timeout = 60
+	c.Parking.Queue.HoldTimeout = 300
+	c.Idempotency.TTL = 24 * 3600
+
+	if err := decode(config, c); err != nil {
+		return c, fmt.Errorf("read config: %w", err)
+	}
+	// slice default set after read, read list never merged into default
+	if c.Idempotency.Methods == nil {
+		c.Idempotency.Methods = []string{"POST"}
+	}
+	c.SecretStore.setDefault()
+	return c, nil
+}
+
+// decode `config` entries into struct `out` by mapstructure tag, weakly typed.
+func decode(config map[string]map[string]interface{}, out interface{}) error {
+	v := viper.New()
+	settings := make(map[string]interface{}, len(config))
+	for k, section := range config {
+		if section != nil {
+			settings[k] = section
+		}
+	}
+	if err := v.MergeConfigMap(settings); err != nil {
+		return err
+	}
+	return v.Unmarshal(out)
+}
+
+// Problems every problem found on config, reported together so all fixed at once.
+type Problems []string
+
+func (p Problems) Error() string {
+	return fmt.Sprintf("invalid config, %d problem(s):\n  %s", len(p), strings.Join(p, "\n  "))
+}
+
+// Validate check every value, nil or Problems listing all invalid key.
+func (c *Config) Validate() error {
+	return c.validate(true)
+}
+
+// ValidateCommand same as Validate for admin command, config read without resolving secret reference
+// so jwt and its secret, only used by server, not checked.
+func (c *Config) ValidateCommand() error {
+	return c.validate(false)
+}
+
+func (c *Config) validate(server bool) error {
+	problems := Problems{}
+
+	validate := validation.New()
+	validate.RegisterTagNameFunc(func(f reflect.StructField) string {
+		return strings.Split(f.Tag.Get("mapstructure"), ",")[0]
+	})
+	if err := validate.Struct(c); err != nil {
+		if fieldErrors, ok := err.(validation.ValidationErrors); ok {
+			for _, fe := range fieldErrors {
+				problems = append(problems, describe(fe))
+			}
+		} else {
+			problems = append(problems, err.Error())
+		}
+	}
+
+	if server && (c.JWT.Enable || c.Server.Uses("jwt")) {
+		if c.Secrets.JWT.Key == "" {
+			problems = append(problems, "secrets.jwt.key: required when jwt enabled")
+		} else if err := jwt.Check(c.JWT, c.Secrets.JWT); err != nil {
+			problems = append(problems, "secrets.jwt.key: "+err.Error())
+		}
+		if c.JWT.Duration <= 0 {
+			problems = append(problems, "jwt.duration: must be positive when jwt enabled")
+		}
+		if c.JWT.EncryptionMethod != "" && c.JWT.CompressionMethod == "" {
+			problems = append(problems, "jwt.compression_method: required when jwt.encryption_method set")
+		}
+		if c.JWT.EncryptionMethod == "" && c.JWT.SigningMethod == "" {
+			problems = append(problems, "jwt.signing_method: required when jwt.encryption_method not set")
+		}
+	}
+	for _, p := range c.SecretStore.Providers {
+		if p == "keystore" && c.SecretStore.Keystore == "" {
+			problems = append(problems, "secret_store.keystore: required when keystore provider listed")
+		}
+	}
+	if c.Server.Listen == "" && c.Server.Port == 0 {
+		problems = append(problems, "server.port: required when server.listen not set")
+	}
+	if c.RateLimit == nil && c.Server.Uses("ratelimit") {
+		problems = append(problems, "ratelimit: required when ratelimit middleware listed")
+	} else if c.RateLimit != nil {
+		if _, err := ratelimit.ParseConfig(c.RateLimit); err != nil {
+			problems = append(problems, "ratelimit: "+err.Error())
+		}
+	}
+
+	if len(problems) > 0 {
+		return problems
+	}
+	return nil
+}
+
+// describe problem of failed rule `fe`, reported by config key.
+func describe(fe validation.FieldError) string {
+	key := fe.Namespace()
+	if i := strings.Index(key, "."); i >= 0 {
+		key = key[i+1:]
+	}
+	var rule string
+	switch fe.Tag() {
+	case "required":
+		rule = "required"
+	case "required_if":
+		rule = "required when " + strings.ToLower(strings.Replace(fe.Param(), " ", " is ", 1))
+	case "oneof":
+		rule = "must be one of " + strings.ReplaceAll(fe.Param(), " ", ", ")
+	case "min":
+		rule = "must be at least " + fe.Param()
+	case "max":
+		rule = "must be at most " + fe.Param()
+	case "hostname_port":
+		rule = "must be host:port"
+	default:
+		rule = "failed on " + fe.Tag()
+	}
+	if got := fmt.Sprint(fe.Value()); got != "" {
+		rule += ", got " + got
+	}
+	return key + ": " + rule
+}
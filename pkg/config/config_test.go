@@ -0,0 +1,116 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ConfigSuite struct {
+	suite.Suite
+}
+
+func (cs *ConfigSuite) TestDefaults() {
+	conf, err := NewConfig(map[string]map[string]interface{}{})
+	cs.NoError(err)
+	cs.NoError(conf.Validate(), "default valid")
+	cs.Equal(":8080", conf.Server.Address(), "port used when listen not set")
+	cs.Equal(30*time.Second, conf.Server.ShutdownWait())
+	cs.Equal("json", conf.FileStorage.Driver)
+	cs.Equal([]string{"POST"}, conf.Idempotency.Methods)
+}
+
+func (cs *ConfigSuite) TestTyped() {
+	conf, err := NewConfig(map[string]map[string]interface{}{
+		"server": {
+			"listen":      "127.0.0.1:9090",
+			"debug":       true,
+			"middlewares": []interface{}{"metrics", "auth"},
+		},
+		"idempotency": {"methods": []interface{}{"PUT"}},
+		"parking":     {"queue": map[string]interface{}{"hold_timeout": "600"}},
+	})
+	cs.NoError(err)
+	cs.Equal("127.0.0.1:9090", conf.Server.Address(), "listen win over port")
+	cs.True(conf.Server.Debug)
+	cs.True(conf.Server.Uses("auth"))
+	cs.Equal([]string{"PUT"}, conf.Idempotency.Methods, "read list replace default")
+	cs.Equal(600, conf.Parking.Queue.HoldTimeout, "number given as string read")
+	cs.NotNil(conf.Raw["server"], "raw entries kept")
+
+	_, err = NewConfig(map[string]map[string]interface{}{"server": {"debug": "maybe"}})
+	cs.Error(err, "value not readable as its type")
+}
+
+func (cs *ConfigSuite) TestValidateReportEveryProblem() {
+	conf, err := NewConfig(map[string]map[string]interface{}{
+		"server": {
+			"listen":      "localhost",
+			"middlewares": []interface{}{"metrics", "auht", "ratelimit"},
+		},
+		"file_storage": {"driver": "mysql", "path": ""},
+		"jwt":          {"enable": true, "duration": 60},
+		"tracing":      {"exporter": "otlp_file", "path": ""},
+		"parking":      {"queue": map[string]interface{}{"hold_timeout": 0}},
+		"gorm":         {"dialect": "postgres"},
+	})
+	cs.NoError(err)
+
+	err = conf.Validate()
+	cs.Require().IsType(Problems{}, err)
+	cs.ElementsMatch(Problems{
+		`server.listen: must be host:port, got localhost`,
+		`server.middlewares[1]: must be one of metrics, tracing, attach_request_id, log, jwt, auth, ratelimit, idempotency, requestid, recover, got auht`,
+		`tracing.path: required when exporter is otlp_file`,
+		`file_storage.driver: must be one of json, got mysql`,
+		`file_storage.path: required`,
+		`gorm.connectionstring: required`,
+		`parking.queue.hold_timeout: must be at least 1, got 0`,
+		`secrets.jwt.key: required when jwt enabled`,
+		`jwt.signing_method: required when jwt.encryption_method not set`,
+		`ratelimit: required when ratelimit middleware listed`,
+	}, err)
+	cs.Contains(err.Error(), "invalid config, 10 problem(s)")
+}
+
+func (cs *ConfigSuite) TestValidateRateLimit() {
+	conf, err := NewConfig(map[string]map[string]interface{}{
+		"ratelimit": {"default": map[string]interface{}{"requests": 10, "period": 60, "by": "user"}},
+	})
+	cs.NoError(err)
+	cs.EqualError(conf.Validate(), Problems{`ratelimit: ratelimit default: unknown key "user", expect ip, subject or route`}.Error())
+}
+
+func (cs *ConfigSuite) TestValidateJWT() {
+	conf, err := NewConfig(map[string]map[string]interface{}{
+		"jwt": {
+			"enable": true, "duration": 60, "encryption_method": "A128CBC",
+			"key_algo": "RSA-OAEP-256", "compression_method": "zip",
+		},
+		"secrets": {"jwt": map[string]interface{}{"key": "not a pem"}},
+	})
+	cs.NoError(err)
+	err = conf.Validate()
+	cs.Require().IsType(Problems{}, err)
+	cs.Len(err, 3)
+	cs.Contains(err.Error(), `jwt.encryption_method: must be one of A128CBC-HS256`)
+	cs.Contains(err.Error(), `jwt.compression_method: must be one of none, deflate, got zip`)
+	cs.Contains(err.Error(), `secrets.jwt.key: `)
+
+	err = conf.ValidateCommand()
+	cs.Require().IsType(Problems{}, err)
+	cs.Len(err, 2, "jwt secret not checked for admin command")
+	cs.NotContains(err.Error(), `secrets.jwt.key: `)
+
+	conf, err = NewConfig(map[string]map[string]interface{}{
+		"jwt":     {"enable": true, "duration": 60, "signing_method": "HS256"},
+		"secrets": {"jwt": map[string]interface{}{"key": "NRKqQdQ9pE0NLDPeUshePA=="}},
+	})
+	cs.NoError(err)
+	cs.NoError(conf.Validate())
+}
+
+func TestConfigSuite(t *testing.T) {
+	suite.Run(t, new(ConfigSuite))
+}
@@ -0,0 +1,270 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/secrets"
+
+	"github.com/spf13/viper"
+)
+
+// EnvPrefix prefix of environment variable overriding config, eg: `PSM_SERVER_LISTEN` override `server.listen`.
+const EnvPrefix = "PSM_"
+
+// LegacyEnv environment variable read before layered config existed, mapped to config key they now override.
+// Overridden by `PSM_` variable of same key.
+var LegacyEnv = map[string]string{
+	"SYSTEM_NAME":                    "system.name",
+	"SERVICE_NAME":                   "system.service_name",
+	"LOG_LEVEL":                      "log.level",
+	"LOG_FORMAT":                     "log.format",
+	"ERROR_DEBUG_LOCATION":           "errors.debug_location",
+	"MASKING_ERROR_MESSAGE":          "errors.masking_message",
+	"DEFAULT_ERROR_TITLE_ID":         "errors.title_id",
+	"DEFAULT_ERROR_DESCRIPTION_ID":   "errors.description_id",
+	"DEFAULT_ERROR_TITLE_EN":         "errors.title_en",
+	"DEFAULT_ERROR_DESCRIPTION_EN":   "errors.description_en",
+	"PAYLOAD_CRYPTO":                 "payload_crypto.enable",
+	"PAYLOAD_CRYPTO_STRICT":          "payload_crypto.strict",
+	"PAYLOAD_CRYPTO_KEY":             "payload_crypto.key",
+	"PAYLOAD_CRYPTO_MIN_APP_VERSION": "payload_crypto.min_app_version",
+	"CIAM_ENABLED_STATUS":            "jwt.ciam_enabled",
+	"FP_MEMBER_CODE_CRYPTO":          "crypto.member_code",
+	"SERVICE_CODE_CRYPTO":            "crypto.service_code",
+	"ITEM_SALT_KEY":                  "crypto.item_salt_key",
+	"SCC_STRICT_LOCS":                "crypto.service_code_strict_locations",
+	"SCC_EXP_SEC":                    "crypto.service_code_expiry",
+	"AUTH_BOOTSTRAP_USERNAME":        "auth.bootstrap.username",
+	"AUTH_BOOTSTRAP_PASSWORD":        "auth.bootstrap.password",
+}
+
+// LayeredProvider load config from layers, later layer win:
+// base files, profile file beside first base file, legacy environment variables then `PSM_` environment variables.
+// `${secret:<key>}` reference resolved last from providers of `secret_store` entry.
+type LayeredProvider struct {
+	profile    string
+	environ    func() []string
+	config     map[string]map[string]interface{}
+	unresolved bool
+}
+
+// NewLayeredProvider provider merging profile `profile` over base files, no profile file read when empty.
+func NewLayeredProvider(profile string) IConfig {
+	return &LayeredProvider{profile: profile, environ: os.Environ, config: map[string]map[string]interface{}{}}
+}
+
+// NewUnresolvedLayeredProvider same as NewLayeredProvider but secret reference kept as written,
+// eg: to read `secret_store` entry while secret still missing.
+func NewUnresolvedLayeredProvider(profile string) IConfig {
+	return &LayeredProvider{profile: profile, environ: os.Environ, config: map[string]map[string]interface{}{}, unresolved: true}
+}
+
+// ProfileFile profile file of `base`, eg: `configs/config.prod.yaml` for profile `prod` of `configs/config.yaml`.
+func ProfileFile(base, profile string) string {
+	ext := filepath.Ext(base)
+	return strings.TrimSuffix(base, ext) + "." + profile + ext
+}
+
+// Files config files read for `uri` and `profile` in merging order: comma separated files of `uri` then profile file.
+func Files(uri, profile string) []string {
+	files := strings.Split(uri, ",")
+	for i := range files {
+		files[i] = strings.TrimSpace(files[i])
+	}
+	if profile != "" {
+		files = append(files, ProfileFile(files[0], profile))
+	}
+	return files
+}
+
+// GetConfig read comma separated files of `uri` in order, then profile file and environment override.
+func (p *LayeredProvider) GetConfig(uri string) error {
+	files := Files(uri, p.profile)
+
+	v := viper.New()
+	for i, f := range files {
+		v.SetConfigFile(f)
+		read := v.MergeInConfig
+		if i == 0 {
+			read = v.ReadInConfig
+		}
+		if err := read(); err != nil {
+			return fmt.Errorf("read config %s: %w", f, err)
+		}
+	}
+
+	config := map[string]map[string]interface{}{}
+	for k, val := range v.AllSettings() {
+		section, ok := val.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("config key %s must be a section, got %T", k, val)
+		}
+		config[k] = section
+	}
+
+	environ := map[string]string{}
+	for _, kv := range p.environ() {
+		if i := strings.Index(kv, "="); i > 0 {
+			environ[kv[:i]] = kv[i+1:]
+		}
+	}
+	legacy := make([]string, 0, len(LegacyEnv))
+	for name := range LegacyEnv {
+		legacy = append(legacy, name)
+	}
+	sort.Strings(legacy)
+	for _, name := range legacy {
+		if value, ok := environ[name]; ok {
+			setPath(config, strings.Split(LegacyEnv[name], "."), value)
+		}
+	}
+
+	overrides := []string{}
+	for name := range environ {
+		if strings.HasPrefix(name, EnvPrefix) && !reservedEnv(name) {
+			overrides = append(overrides, name)
+		}
+	}
+	sort.Strings(overrides)
+	for _, name := range overrides {
+		parts := strings.Split(strings.ToLower(strings.TrimPrefix(name, EnvPrefix)), "_")
+		setPath(config, resolveEnvPath(config, parts), environ[name])
+	}
+
+	if !p.unresolved {
+		if err := resolveSecrets(config, environ); err != nil {
+			return err
+		}
+	}
+
+	p.config = config
+	return nil
+}
+
+// reservedEnv `PSM_` variable not overriding config: selecting config, or holding keystore master key.
+func reservedEnv(name string) bool {
+	return name == EnvPrefix+"PROFILE" || name == EnvPrefix+"CONFIG" || name == secrets.EnvKeystoreKey
+}
+
+// resolveSecrets resolve secret reference of `config` from providers of `secret_store` entry, default env then file.
+// Value of `secrets` entry registered for masking even when still written inline, leaked credential rejected.
+func resolveSecrets(config map[string]map[string]interface{}, environ map[string]string) error {
+	entry := struct {
+		Store SecretStoreConfig `mapstructure:"secret_store"`
+	}{}
+	if err := decode(map[string]map[string]interface{}{"secret_store": config["secret_store"]}, &entry); err != nil {
+		return fmt.Errorf("read config secret_store: %w", err)
+	}
+	store := entry.Store
+	store.setDefault()
+
+	lookup := func(name string) (string, bool) {
+		v, ok := environ[name]
+		return v, ok
+	}
+	provider, err := secrets.New(store.Providers, store.Dir, store.Keystore, lookup)
+	if err != nil {
+		return fmt.Errorf("secret store: %w", err)
+	}
+	if err := secrets.Resolve(config, provider); err != nil {
+		return fmt.Errorf("resolve secret: %w", err)
+	}
+	secrets.RegisterSection(config["secrets"])
+	return secrets.CheckLeaked(config["secrets"])
+}
+
+// Config return config readed
+func (p *LayeredProvider) Config() map[string]map[string]interface{} {
+	return p.config
+}
+
+// resolveEnvPath key path of env variable split by `_`, matched against existing keys so key containing `_` resolved,
+// eg: `file_storage_path` resolved to `file_storage.path`. Unknown rest joined as single key of deepest matched section.
+func resolveEnvPath(config map[string]map[string]interface{}, parts []string) []string {
+	sections := map[string]interface{}{}
+	for k, v := range config {
+		sections[k] = v
+	}
+
+	var path []string
+	var node interface{} = sections
+	for len(parts) > 0 {
+		m, ok := node.(map[string]interface{})
+		if !ok {
+			break
+		}
+		matched := 0
+		for n := len(parts); n > 0; n-- {
+			if _, ok := m[strings.Join(parts[:n], "_")]; ok {
+				matched = n
+				break
+			}
+		}
+		if matched == 0 {
+			break
+		}
+		key := strings.Join(parts[:matched], "_")
+		path, parts, node = append(path, key), parts[matched:], m[key]
+	}
+	if len(parts) == 0 {
+		return path
+	}
+	if len(path) == 0 && len(parts) > 1 {
+		// new section, first part taken as section name
+		return []string{parts[0], strings.Join(parts[1:], "_")}
+	}
+	return append(path, strings.Join(parts, "_"))
+}
+
+// setPath set `value` parsed as scalar on key `path`, creating missing section.
+// Value of existing list split by comma.
+func setPath(config map[string]map[string]interface{}, path []string, value string) {
+	if len(path) < 2 {
+		return
+	}
+	section, ok := config[path[0]]
+	if !ok {
+		section = map[string]interface{}{}
+		config[path[0]] = section
+	}
+	node := section
+	for _, key := range path[1 : len(path)-1] {
+		child, ok := node[key].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			node[key] = child
+		}
+		node = child
+	}
+	leaf := path[len(path)-1]
+	if _, isList := node[leaf].([]interface{}); isList {
+		items := []interface{}{}
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, parseScalar(item))
+			}
+		}
+		node[leaf] = items
+		return
+	}
+	node[leaf] = parseScalar(value)
+}
+
+// parseScalar value of environment variable as bool or number when it look like one, string otherwise.
+func parseScalar(value string) interface{} {
+	if b, err := strconv.ParseBool(value); err == nil && value != "1" && value != "0" {
+		return b
+	}
+	if i, err := strconv.Atoi(value); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f
+	}
+	return value
+}
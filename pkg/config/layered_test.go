@@ -0,0 +1,134 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/secrets"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LayeredProviderSuite struct {
+	suite.Suite
+	base string
+}
+
+func (ls *LayeredProviderSuite) SetupTest() {
+	dir := ls.T().TempDir()
+	ls.base = filepath.Join(dir, "config.yaml")
+	ls.write(ls.base, `
+server:
+  listen: 0.0.0.0:8080
+  debug: true
+  middlewares: [metrics, log]
+file_storage:
+  path: storage/
+ratelimit:
+  default:
+    requests: 300
+`)
+	ls.write(filepath.Join(dir, "config.prod.yaml"), `
+server:
+  debug: false
+log:
+  level: warn
+`)
+}
+
+func (ls *LayeredProviderSuite) write(path, content string) {
+	ls.Require().NoError(os.WriteFile(path, []byte(content), 0644))
+}
+
+func (ls *LayeredProviderSuite) load(profile string, env ...string) (map[string]map[string]interface{}, error) {
+	p := &LayeredProvider{profile: profile, environ: func() []string { return env }}
+	err := p.GetConfig(ls.base)
+	return p.Config(), err
+}
+
+func (ls *LayeredProviderSuite) TestProfileOverBase() {
+	conf, err := ls.load("prod")
+	ls.NoError(err)
+	ls.Equal(false, conf["server"]["debug"], "profile win over base")
+	ls.Equal("0.0.0.0:8080", conf["server"]["listen"], "key not in profile kept")
+	ls.Equal("warn", conf["log"]["level"], "section only in profile added")
+
+	_, err = ls.load("staging")
+	ls.Error(err, "selected profile must exist")
+}
+
+func (ls *LayeredProviderSuite) TestEnvOverride() {
+	conf, err := ls.load("prod",
+		"PSM_SERVER_LISTEN=127.0.0.1:9090",
+		"PSM_FILE_STORAGE_PATH=/data/",
+		"PSM_RATELIMIT_DEFAULT_REQUESTS=10",
+		"PSM_SERVER_MIDDLEWARES=metrics, tracing",
+		"PSM_TRACING_SERVICE_NAME=parking",
+		"LOG_LEVEL=debug",
+		"PSM_LOG_FORMAT=json",
+		"AUTH_BOOTSTRAP_PASSWORD=secret",
+		"PSM_PROFILE=ignored",
+	)
+	ls.NoError(err)
+	ls.Equal("127.0.0.1:9090", conf["server"]["listen"])
+	ls.Equal("/data/", conf["file_storage"]["path"], "key with underscore resolved against existing keys")
+	ls.Equal(10, conf["ratelimit"]["default"].(map[string]interface{})["requests"])
+	ls.Equal([]interface{}{"metrics", "tracing"}, conf["server"]["middlewares"])
+	ls.Equal("parking", conf["tracing"]["service_name"], "unknown key joined under new section")
+	ls.Equal("debug", conf["log"]["level"], "legacy variable win over files")
+	ls.Equal("json", conf["log"]["format"])
+	ls.NotContains(conf, "profile")
+
+	rt, err := NewConfig(conf)
+	ls.NoError(err)
+	ls.Equal("debug", rt.Log.Level)
+	ls.Equal("secret", rt.Auth.Bootstrap.Password)
+	ls.Equal("admin", rt.Auth.Bootstrap.Username, "default kept when not configured")
+	ls.Equal("SYSTEM", rt.System.Name)
+}
+
+func (ls *LayeredProviderSuite) TestPrefixedWinOverLegacy() {
+	conf, err := ls.load("", "LOG_LEVEL=debug", "PSM_LOG_LEVEL=info", "PAYLOAD_CRYPTO=1")
+	ls.NoError(err)
+	ls.Equal("info", conf["log"]["level"])
+
+	rt, err := NewConfig(conf)
+	ls.NoError(err)
+	ls.True(rt.PayloadCrypto.Enable, "legacy `1` flag read as enabled")
+}
+
+func (ls *LayeredProviderSuite) TestSecretReference() {
+	ls.write(ls.base, `
+secret_store:
+  providers: [env]
+secrets:
+  jwt:
+    key: ${secret:jwt.key}
+  legacy:
+    token: written-inline
+gorm:
+  connectionstring: postgres://app:${secret:db.password}@db/parking
+`)
+	conf, err := ls.load("", "SECRET_JWT_KEY=jwt-value", "SECRET_DB_PASSWORD=db-value", "PSM_KEYSTORE_KEY=master")
+	ls.NoError(err)
+	ls.Equal("jwt-value", conf["secrets"]["jwt"].(map[string]interface{})["key"])
+	ls.Equal("postgres://app:db-value@db/parking", conf["gorm"]["connectionstring"])
+	ls.NotContains(conf, "keystore", "master key never applied as override")
+	ls.Equal("token ******", secrets.Mask("token written-inline"), "inline secret masked too")
+
+	_, err = ls.load("", "SECRET_JWT_KEY=jwt-value")
+	ls.EqualError(err, "resolve secret: secret db.password not found on any provider")
+
+	p := &LayeredProvider{environ: func() []string { return nil }, unresolved: true}
+	ls.NoError(p.GetConfig(ls.base), "reference kept when unresolved")
+	ls.Equal("${secret:jwt.key}", p.Config()["secrets"]["jwt"].(map[string]interface{})["key"])
+}
+
+func (ls *LayeredProviderSuite) TestProfileFile() {
+	ls.Equal("configs/config.prod.yaml", ProfileFile("configs/config.yaml", "prod"))
+}
+
+func TestLayeredProviderSuite(t *testing.T) {
+	suite.Run(t, new(LayeredProviderSuite))
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/crypts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/jwt"
+	"github.com/mhaikalla/parking-service-management-library/pkg/logs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/payloadhooks"
+)
+
+// Apply set runtime settings on package reading them, settings formerly read ad hoc from environment,
+// see LegacyEnv for variable still honored.
+func (c *Config) Apply() {
+	logs.ServiceName = c.System.ServiceName
+	logs.LogLevel = c.Log.Level
+	logs.LogFormat = c.Log.Format
+
+	errs.ErrorDebugLocation = flag(c.Errors.DebugLocation)
+	errs.MaskingErrorMessage = flag(c.Errors.MaskingMessage)
+	errs.DefaultErrTitle = c.Errors.TitleID
+	errs.DefaultErrDesc = c.Errors.DescriptionID
+	errs.DefaultErrTitleEN = c.Errors.TitleEN
+	errs.DefaultErrDescEN = c.Errors.DescriptionEN
+
+	payloadhooks.SetPayloadCryptoFeature(c.PayloadCrypto.Enable)
+	payloadhooks.PayloadCryptoStrict = c.PayloadCrypto.Strict
+	payloadhooks.PayloadCryptoKey = c.PayloadCrypto.Key
+	payloadhooks.PayloadCryptoMinAppVer = c.PayloadCrypto.MinAppVersion
+
+	if c.JWT.CiamEnabled {
+		jwt.CiamEnabledStatus = "true"
+	} else {
+		jwt.CiamEnabledStatus = ""
+	}
+
+	crypts.FPMemberCodeCrypto = flag(c.Crypto.MemberCode)
+	crypts.ServiceCodeCryptoEnabled = flag(c.Crypto.ServiceCode)
+	var strictLocations []string
+	if c.Crypto.ServiceCodeStrictLocations != "" {
+		strictLocations = strings.Split(c.Crypto.ServiceCodeStrictLocations, ";")
+	}
+	crypts.ConfigureServiceCode(c.Crypto.ItemSaltKey, strictLocations, c.Crypto.ServiceCodeExpiry)
+}
+
+// ApplyReloadable set runtime settings of Reloadable keys safe to change while serving request,
+// applied on config reload instead of Apply. Long lived `loggers` follow reloaded log level too.
+func (c *Config) ApplyReloadable(loggers ...logs.ILog) {
+	logs.SetLevel(c.Log.Level, loggers...)
+	payloadhooks.SetPayloadCryptoFeature(c.PayloadCrypto.Enable)
+}
+
+// flag `1` when enabled, how package read on/off setting.
+func flag(enabled bool) string {
+	if enabled {
+		return "1"
+	}
+	return ""
+}
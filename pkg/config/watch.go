@@ -0,0 +1,292 @@
+package config
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+)
+
+// Reloadable config keys applied while serving, change of any other key only applied on restart.
+// None of them install middleware, eg: `payload_crypto.enable` read by every request from payloadhooks.
+var Reloadable = []string{"ratelimit", "log.level", "parking.queue", "payload_crypto.enable"}
+
+// WatchDelay wait after last file event before reloading, editor saving file emit several events.
+const WatchDelay = 500 * time.Millisecond
+
+// Change config-changed event emitted after reloaded config swapped in.
+type Change struct {
+	Old *Config
+	New *Config
+	// Keys reloadable keys changed, applied on New.
+	Keys []string
+	// Pending keys changed but only applied on restart, New keep their running value.
+	Pending []string
+}
+
+// Watcher reload config when its files change, reloadable part swapped in once whole config validated.
+// Running config kept when reloaded config invalid.
+type Watcher struct {
+	load    func() (*Config, error)
+	current atomic.Value // *Config
+
+	mu        sync.Mutex
+	checks    []func(*Config) error
+	listeners []func(Change)
+	failures  []func(error)
+	timer     *time.Timer
+	stopped   bool
+
+	reloading sync.Mutex
+}
+
+// NewWatcher watcher of running config `initial`, config reloaded by `load`.
+func NewWatcher(initial *Config, load func() (*Config, error)) *Watcher {
+	w := &Watcher{load: load}
+	w.current.Store(initial)
+	return w
+}
+
+// Loader load config of `uri` and `profile` as NewLayeredProvider, error when config invalid.
+func Loader(uri, profile string) func() (*Config, error) {
+	return func() (*Config, error) {
+		provider := NewLayeredProvider(profile)
+		if err := provider.GetConfig(uri); err != nil {
+			return nil, err
+		}
+		conf, err := NewConfig(provider.Config())
+		if err != nil {
+			return nil, err
+		}
+		if err := conf.Validate(); err != nil {
+			return nil, err
+		}
+		return conf, nil
+	}
+}
+
+// Current running config.
+func (w *Watcher) Current() *Config {
+	return w.current.Load().(*Config)
+}
+
+// OnCheck call `fn` on reloaded config before it is swapped in, error keep running config so listener
+// applying it never fail halfway.
+func (w *Watcher) OnCheck(fn func(*Config) error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.checks = append(w.checks, fn)
+}
+
+// OnChange call `fn` after every reload changing config, in registering order.
+func (w *Watcher) OnChange(fn func(Change)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.listeners = append(w.listeners, fn)
+}
+
+// OnError call `fn` when reload triggered by file change failed.
+func (w *Watcher) OnError(fn func(error)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.failures = append(w.failures, fn)
+}
+
+// Reload load config then swap in its reloadable part, change emitted to OnChange listeners.
+// Nil change when nothing changed, running config kept on error.
+func (w *Watcher) Reload() (*Change, error) {
+	w.reloading.Lock()
+	defer w.reloading.Unlock()
+
+	fresh, err := w.load()
+	if err != nil {
+		return nil, err
+	}
+	old := w.Current()
+
+	merged := *old
+	merged.RateLimit = fresh.RateLimit
+	merged.Log.Level = fresh.Log.Level
+	merged.Parking.Queue = fresh.Parking.Queue
+	merged.PayloadCrypto.Enable = fresh.PayloadCrypto.Enable
+	merged.Raw = old.Raw
+	for _, key := range Reloadable {
+		merged.Raw = graft(merged.Raw, fresh.Raw, strings.Split(key, "."))
+	}
+	// reloadable part checked against running part, eg: `ratelimit` required by running middlewares
+	if err := merged.Validate(); err != nil {
+		return nil, err
+	}
+	w.mu.Lock()
+	checks := append([]func(*Config) error{}, w.checks...)
+	w.mu.Unlock()
+	for _, check := range checks {
+		if err := check(&merged); err != nil {
+			return nil, err
+		}
+	}
+
+	change := &Change{Old: old, New: old}
+	for _, key := range diff(flatten(old.Raw), flatten(fresh.Raw)) {
+		if reloadable(key) {
+			change.Keys = append(change.Keys, key)
+		} else {
+			change.Pending = append(change.Pending, key)
+		}
+	}
+	if len(change.Keys) == 0 && len(change.Pending) == 0 {
+		return nil, nil
+	}
+	if len(change.Keys) > 0 {
+		change.New = &merged
+		w.current.Store(change.New)
+	}
+
+	w.mu.Lock()
+	listeners := append([]func(Change){}, w.listeners...)
+	w.mu.Unlock()
+	for _, fn := range listeners {
+		fn(*change)
+	}
+	return change, nil
+}
+
+// Watch reload config when any of `files` written, see Files. Reload delayed by WatchDelay so burst of
+// events reload once.
+func (w *Watcher) Watch(files ...string) {
+	for _, f := range files {
+		v := viper.New()
+		v.SetConfigFile(f)
+		v.OnConfigChange(func(fsnotify.Event) { w.schedule() })
+		v.WatchConfig()
+	}
+}
+
+// Stop stop reloading on file change, pending reload dropped.
+func (w *Watcher) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.stopped = true
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+}
+
+// schedule reload after WatchDelay, postponed by every event came before.
+func (w *Watcher) schedule() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.stopped {
+		return
+	}
+	if w.timer != nil {
+		w.timer.Reset(WatchDelay)
+		return
+	}
+	w.timer = time.AfterFunc(WatchDelay, func() {
+		if _, err := w.Reload(); err != nil {
+			w.mu.Lock()
+			failures := append([]func(error){}, w.failures...)
+			w.mu.Unlock()
+			for _, fn := range failures {
+				fn(err)
+			}
+		}
+	})
+}
+
+// reloadable check whether `key` is, or is under, a Reloadable key.
+func reloadable(key string) bool {
+	for _, r := range Reloadable {
+		if key == r || strings.HasPrefix(key, r+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// graft copy of `dst` with value at `path` taken from `src`, removed when `src` not having it.
+// Maps along the path copied, `dst` itself left untouched.
+func graft(dst, src map[string]map[string]interface{}, path []string) map[string]map[string]interface{} {
+	out := make(map[string]map[string]interface{}, len(dst))
+	for k, v := range dst {
+		out[k] = v
+	}
+	if len(path) == 1 {
+		if section, ok := src[path[0]]; ok {
+			out[path[0]] = section
+		} else {
+			delete(out, path[0])
+		}
+		return out
+	}
+
+	var from interface{}
+	if section, ok := src[path[0]]; ok {
+		from = section
+	}
+	section, _ := graftNode(dst[path[0]], from, path[1:]).(map[string]interface{})
+	out[path[0]] = section
+	return out
+}
+
+func graftNode(dst, src interface{}, path []string) interface{} {
+	node := map[string]interface{}{}
+	if m, ok := dst.(map[string]interface{}); ok {
+		for k, v := range m {
+			node[k] = v
+		}
+	}
+	from, _ := src.(map[string]interface{})
+	value, found := from[path[0]]
+	switch {
+	case len(path) > 1:
+		node[path[0]] = graftNode(node[path[0]], value, path[1:])
+	case found:
+		node[path[0]] = value
+	default:
+		delete(node, path[0])
+	}
+	return node
+}
+
+// flatten leaf values of `config` by dotted key, eg: `server.port`. List kept as single value.
+func flatten(config map[string]map[string]interface{}) map[string]interface{} {
+	flat := map[string]interface{}{}
+	var walk func(prefix string, node map[string]interface{})
+	walk = func(prefix string, node map[string]interface{}) {
+		for k, v := range node {
+			if m, ok := v.(map[string]interface{}); ok {
+				walk(prefix+k+".", m)
+				continue
+			}
+			flat[prefix+k] = v
+		}
+	}
+	for k, section := range config {
+		walk(k+".", section)
+	}
+	return flat
+}
+
+// diff keys of `a` and `b` having different value, sorted.
+func diff(a, b map[string]interface{}) []string {
+	keys := []string{}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || !reflect.DeepEqual(v, w) {
+			keys = append(keys, k)
+		}
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
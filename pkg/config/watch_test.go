@@ -0,0 +1,198 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/secrets"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type WatcherSuite struct {
+	suite.Suite
+	raw map[string]map[string]interface{}
+}
+
+func (ws *WatcherSuite) SetupTest() {
+	ws.raw = map[string]map[string]interface{}{
+		"system":       {"service_name": "parking"},
+		"server":       {"port": 8080},
+		"file_storage": {"path": "storage/"},
+		"log":          {"level": "error", "format": "text"},
+		"parking":      {"queue": map[string]interface{}{"hold_timeout": 300}},
+	}
+}
+
+func (ws *WatcherSuite) config(raw map[string]map[string]interface{}) *Config {
+	conf, err := NewConfig(raw)
+	ws.Require().NoError(err)
+	return conf
+}
+
+// loader load copy of `raw` as edited by `edit`
+func (ws *WatcherSuite) loader(edit func(raw map[string]map[string]interface{})) func() (*Config, error) {
+	return func() (*Config, error) {
+		raw := map[string]map[string]interface{}{}
+		for k, section := range ws.raw {
+			raw[k] = map[string]interface{}{}
+			for kk, v := range section {
+				raw[k][kk] = v
+			}
+		}
+		edit(raw)
+		conf, err := NewConfig(raw)
+		if err != nil {
+			return nil, err
+		}
+		return conf, conf.Validate()
+	}
+}
+
+func (ws *WatcherSuite) TestReloadSwapReloadable() {
+	initial := ws.config(ws.raw)
+	w := NewWatcher(initial, ws.loader(func(raw map[string]map[string]interface{}) {
+		raw["parking"]["queue"] = map[string]interface{}{"hold_timeout": 900}
+		raw["log"]["level"] = "debug"
+		raw["server"]["port"] = 9090
+	}))
+	events := []Change{}
+	w.OnChange(func(c Change) { events = append(events, c) })
+
+	change, err := w.Reload()
+	ws.Require().NoError(err)
+	ws.Equal([]string{"log.level", "parking.queue.hold_timeout"}, change.Keys)
+	ws.Equal([]string{"server.port"}, change.Pending, "key need restart reported")
+	ws.Len(events, 1, "config-changed event emitted")
+
+	current := w.Current()
+	ws.Same(change.New, current)
+	ws.Equal(900, current.Parking.Queue.HoldTimeout)
+	ws.Equal("debug", current.Log.Level)
+	ws.Equal(8080, current.Server.Port, "key need restart keep running value")
+	ws.Equal(300, initial.Parking.Queue.HoldTimeout, "running config never mutated")
+	ws.Equal(900, current.Raw["parking"]["queue"].(map[string]interface{})["hold_timeout"])
+	ws.Equal(300, initial.Raw["parking"]["queue"].(map[string]interface{})["hold_timeout"])
+
+	change, err = w.Reload()
+	ws.NoError(err)
+	ws.Empty(change.Keys, "reloadable key already applied")
+	ws.Equal([]string{"server.port"}, change.Pending)
+	ws.Same(current, w.Current())
+}
+
+func (ws *WatcherSuite) TestReloadKeepOldOnFailure() {
+	initial := ws.config(ws.raw)
+	w := NewWatcher(initial, ws.loader(func(raw map[string]map[string]interface{}) {
+		raw["parking"]["queue"] = map[string]interface{}{"hold_timeout": 0}
+	}))
+	called := false
+	w.OnChange(func(Change) { called = true })
+
+	_, err := w.Reload()
+	ws.Error(err, "invalid config rejected")
+	ws.Same(initial, w.Current(), "old config kept")
+	ws.False(called)
+
+	w = NewWatcher(initial, func() (*Config, error) { return nil, errors.New("broken yaml") })
+	_, err = w.Reload()
+	ws.Error(err)
+	ws.Same(initial, w.Current())
+}
+
+func (ws *WatcherSuite) TestReloadKeepOldOnCheckFailure() {
+	initial := ws.config(ws.raw)
+	w := NewWatcher(initial, ws.loader(func(raw map[string]map[string]interface{}) {
+		raw["parking"]["queue"] = map[string]interface{}{"hold_timeout": 900}
+	}))
+	checked := 0
+	w.OnCheck(func(conf *Config) error {
+		checked = conf.Parking.Queue.HoldTimeout
+		return errors.New("can not apply")
+	})
+	called := false
+	w.OnChange(func(Change) { called = true })
+
+	_, err := w.Reload()
+	ws.Error(err, "config rejected by check")
+	ws.Equal(900, checked, "reloaded config checked")
+	ws.Same(initial, w.Current(), "old config kept")
+	ws.False(called, "nothing applied")
+}
+
+func (ws *WatcherSuite) TestReloadValidatedAgainstRunning() {
+	ws.raw["server"]["middlewares"] = []interface{}{"ratelimit"}
+	ws.raw["ratelimit"] = map[string]interface{}{"default": map[string]interface{}{"requests": 10, "period": 60}}
+	initial := ws.config(ws.raw)
+	w := NewWatcher(initial, ws.loader(func(raw map[string]map[string]interface{}) {
+		raw["server"]["middlewares"] = []interface{}{}
+		delete(raw, "ratelimit")
+	}))
+
+	_, err := w.Reload()
+	ws.Error(err, "ratelimit still required by running middlewares")
+	ws.Same(initial, w.Current())
+}
+
+func (ws *WatcherSuite) TestReloadNothingChanged() {
+	initial := ws.config(ws.raw)
+	w := NewWatcher(initial, ws.loader(func(map[string]map[string]interface{}) {}))
+	change, err := w.Reload()
+	ws.NoError(err)
+	ws.Nil(change)
+	ws.Same(initial, w.Current())
+}
+
+func (ws *WatcherSuite) TestWatchFile() {
+	file := filepath.Join(ws.T().TempDir(), "config.yaml")
+	write := func(holdTimeout int) {
+		content := "system:\n  service_name: parking\nfile_storage:\n  path: storage/\nparking:\n  queue:\n    hold_timeout: " +
+			strconv.Itoa(holdTimeout) + "\n"
+		ws.Require().NoError(os.WriteFile(file, []byte(content), 0644))
+	}
+	write(300)
+	load := Loader(file, "")
+	initial, err := load()
+	ws.Require().NoError(err)
+
+	w := NewWatcher(initial, load)
+	defer w.Stop()
+	changed := make(chan Change, 1)
+	w.OnChange(func(c Change) { changed <- c })
+	w.Watch(Files(file, "")...)
+
+	write(600)
+	select {
+	case c := <-changed:
+		ws.Equal([]string{"parking.queue.hold_timeout"}, c.Keys)
+		ws.Equal(600, w.Current().Parking.Queue.HoldTimeout)
+	case <-time.After(5 * time.Second):
+		ws.Fail("config change not picked up")
+	}
+}
+
+func (ws *WatcherSuite) TestLoaderResolveSecret() {
+	file := filepath.Join(ws.T().TempDir(), "config.yaml")
+	content := "system:\n  service_name: parking\nfile_storage:\n  path: storage/\n" +
+		"secrets:\n  jwt:\n    key: ${secret:jwt.key}\n"
+	ws.Require().NoError(os.WriteFile(file, []byte(content), 0644))
+	ws.Require().NoError(os.Setenv(secrets.EnvName("jwt.key"), "reloaded-jwt-secret"))
+	defer os.Unsetenv(secrets.EnvName("jwt.key"))
+
+	conf, err := Loader(file, "")()
+	ws.Require().NoError(err)
+	ws.Equal("reloaded-jwt-secret", conf.Raw["secrets"]["jwt"].(map[string]interface{})["key"], "reference resolved on reload")
+	ws.Equal("key=******", secrets.Mask("key=reloaded-jwt-secret"), "reloaded secret masked")
+
+	ws.Require().NoError(os.Setenv(secrets.EnvName("jwt.key"), "P@ssw0rd"))
+	_, err = Loader(file, "")()
+	ws.Error(err, "leaked credential rejected on reload")
+}
+
+func TestWatcherSuite(t *testing.T) {
+	suite.Run(t, new(WatcherSuite))
+}
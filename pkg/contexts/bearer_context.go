@@ -3,7 +3,7 @@ package contexts
 import (
 	"context"
 	"encoding/json"
-	"os"
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -12,12 +12,12 @@ import (
 	"github.com/mhaikalla/parking-service-management-library/pkg/jwt"
 	"github.com/mhaikalla/parking-service-management-library/pkg/logs"
 	"github.com/mhaikalla/parking-service-management-library/pkg/payloadhooks"
+	"github.com/mhaikalla/parking-service-management-library/pkg/tracing"
 
 	"github.com/labstack/echo/v4"
 )
 
 var (
-	serviceName   = os.Getenv("SERVICE_NAME")
 	reBearerToken = regexp.MustCompile(`[A-Za-z0-9-_=]+\.[A-Za-z0-9-_=]+\.?[A-Za-z0-9-_.+/=]*$`)
 )
 
@@ -182,9 +182,64 @@ func (bc *BearerContext) GetSubsType() string {
 	return bc.SideLoad.BearerData.Audience
 }
 
+// InvalidSiteID site ID of invalid route param `siteId`, matching no site.
+const InvalidSiteID = -1
+
+// ParseSiteID parse route param `siteId`, error when not a site ID.
+func ParseSiteID(param string) (int, error) {
+	siteID, err := strconv.Atoi(param)
+	if err != nil || siteID < 0 {
+		return InvalidSiteID, fmt.Errorf("invalid site id %q", param)
+	}
+	return siteID, nil
+}
+
+// GetSiteID get site ID from route param `siteId`, fallback to site ID from JWT Claim.
+// Return 0 (default site) when both not provided, InvalidSiteID when param invalid,
+// such request already rejected with 400 by router.
+func (bc *BearerContext) GetSiteID() int {
+	if bc.Context == nil {
+		return bc.SideLoad.BearerData.SiteID
+	}
+	if param := bc.Param("siteId"); param != "" {
+		siteID, _ := ParseSiteID(param)
+		return siteID
+	}
+	return bc.SideLoad.BearerData.SiteID
+}
+
+// GetRoles get roles of caller from JWT Claim.
+func (bc *BearerContext) GetRoles() []string {
+	return bc.SideLoad.BearerData.Roles
+}
+
+// HasRole check whether caller has one of `roles`.
+func (bc *BearerContext) HasRole(roles ...string) bool {
+	for _, role := range bc.SideLoad.BearerData.Roles {
+		for _, r := range roles {
+			if role == r {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CanAccessSite check whether caller allowed on site `siteID`, its own site from JWT Claim unless allowed on every site.
+func (bc *BearerContext) CanAccessSite(siteID int) bool {
+	return bc.SideLoad.BearerData.AllSites || bc.SideLoad.BearerData.SiteID == siteID
+}
+
 // GetRequestID get request ID from request header X-Request-ID.
+// Fallback to ID generated by `requestid` middleware when not attached to context.
 func (bc *BearerContext) GetRequestID() string {
-	return bc.RequestID
+	if bc.RequestID != "" || bc.Context == nil {
+		return bc.RequestID
+	}
+	if id := bc.Response().Header().Get(echo.HeaderXRequestID); id != "" {
+		return id
+	}
+	return bc.Request().Header.Get(echo.HeaderXRequestID)
 }
 
 // GetRequestContext get request context.
@@ -202,6 +257,21 @@ func (bc *BearerContext) GetRequestContext() context.Context {
 	return contextWithValues
 }
 
+// StartSpan start span `name` child of span on request context, request context carry new span until returned func called.
+func (bc *BearerContext) StartSpan(name string) (context.Context, func()) {
+	if bc.Context == nil || bc.Request() == nil {
+		ctx, span := tracing.Start(context.Background(), name)
+		return ctx, span.End
+	}
+	req := bc.Request()
+	ctx, span := tracing.Start(req.Context(), name)
+	bc.SetRequest(req.WithContext(ctx))
+	return ctx, func() {
+		span.End()
+		bc.SetRequest(req)
+	}
+}
+
 // Logger return log wrapper.
 func (bc BearerContext) GetLogger() logs.ILog {
 	if bc.logger != nil {
@@ -262,15 +332,15 @@ func (bc *BearerContext) GetFirstlogin() string {
 }
 
 // EnsureBearerContext ensure output is `BearerContext`, if not, wrap it on `BearerContext`.
-// will ensure payload hook enable when env `PAYLOAD_CRYPTO` set to `1`
+// will ensure payload hook enable when `payload_crypto.enable` config set
 func EnsureBearerContext(maybeContext interface{}) BearerContext {
-	payloadCryptoEnabled := (payloadhooks.PayloadCryptoFeature == "1")
+	payloadCryptoEnabled := payloadhooks.PayloadCryptoEnabled()
 	if bearerContext, bcOK := maybeContext.(BearerContext); bcOK {
 		versionCode := extractVersionCode(bearerContext.Request().Header.Get("user-agent"))
 		pcMinAppVer, _ := strconv.Atoi(payloadhooks.PayloadCryptoMinAppVer)
-		payloadCryptoEnabled = versionCode > pcMinAppVer && (payloadhooks.PayloadCryptoFeature == "1")
+		payloadCryptoEnabled = versionCode > pcMinAppVer && payloadhooks.PayloadCryptoEnabled()
 		if bearerContext.logger == nil {
-			bearerContext.logger = logs.NewLogrus(serviceName)
+			bearerContext.logger = logs.NewLogrus(logs.ServiceName)
 		}
 		return ensurePayloadHooks(bearerContext, payloadCryptoEnabled)
 	}
@@ -278,13 +348,13 @@ func EnsureBearerContext(maybeContext interface{}) BearerContext {
 	if echoContext, ecOK := maybeContext.(echo.Context); ecOK {
 		versionCode := extractVersionCode(echoContext.Request().Header.Get("user-agent"))
 		pcMinAppVer, _ := strconv.Atoi(payloadhooks.PayloadCryptoMinAppVer)
-		payloadCryptoEnabled = versionCode > pcMinAppVer && (payloadhooks.PayloadCryptoFeature == "1")
+		payloadCryptoEnabled = versionCode > pcMinAppVer && payloadhooks.PayloadCryptoEnabled()
 	}
 
 	return ensurePayloadHooks(
 		BearerContext{
 			Context: maybeContext.(echo.Context),
-			logger:  logs.NewLogrus(serviceName),
+			logger:  logs.NewLogrus(logs.ServiceName),
 		},
 		payloadCryptoEnabled,
 	)
@@ -6,7 +6,6 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
-	"os"
 	"regexp"
 	"strconv"
 	"strings"
@@ -14,9 +13,9 @@ import (
 	"github.com/google/uuid"
 )
 
-//  Contains Env Var for activating Family Plan Member Code Crypto.
+//  Contains flag for activating Family Plan Member Code Crypto, set from `crypto.member_code` config.
 var (
-	FPMemberCodeCrypto = os.Getenv("FP_MEMBER_CODE_CRYPTO")
+	FPMemberCodeCrypto string
 	reUUID             = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
 	errorLayout        = "Member Code Crypto: %v"
 )
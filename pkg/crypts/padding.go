@@ -20,11 +20,15 @@ func Pad(buf []byte, size int) ([]byte, error) {
 // For compability with PKCS #5, `size` is size of AES block cipher.
 // Size of AES block cipher is 16 byte.
 func Unpad(padded []byte, size int) ([]byte, error) {
-	if len(padded)%size != 0 { // check if length of byte slice is divided by the size
+	if len(padded) == 0 || len(padded)%size != 0 { // check if length of byte slice is divided by the size
 		return nil, errors.New("pkcs7: Padded value wasn't in correct size")
 	}
 
-	bufLen := len(padded) - int(padded[len(padded)-1]) // calculate the size of padded char
+	padLen := int(padded[len(padded)-1])
+	if padLen == 0 || padLen > size || padLen > len(padded) { // decrypted by wrong key
+		return nil, errors.New("pkcs7: invalid padding")
+	}
+	bufLen := len(padded) - padLen // calculate the size of padded char
 
 	buf := make([]byte, bufLen) // create a new byte slice
 	copy(buf, padded[:bufLen])  // copy the result so we're not changing original byte slice
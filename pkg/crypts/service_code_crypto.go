@@ -7,19 +7,16 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"errors"
-	"log"
-	"os"
-	"regexp"
 	"runtime"
-	"strconv"
 	"strings"
 	"time"
 
-	"github.com/mhaikalla/parking-service-management-library/pkg/condutils"
-
 	"golang.org/x/crypto/pbkdf2"
 )
 
+// DefaultServiceCodeExpiry seconds service code session valid when not configured.
+const DefaultServiceCodeExpiry = 60
+
 const (
 	serviceCodePrefix   = `SC__`
 	sessionExpiredError = `your service code session expired, please refresh it`
@@ -27,9 +24,9 @@ const (
 )
 
 var (
-	nounceKey       = condutils.Or(os.Getenv("ITEM_SALT_KEY"), "7Bu3hd69eZx5X0jJEDzuNT54uK46md").(string)
+	nounceKey       = "7Bu3hd69eZx5X0jJEDzuNT54uK46md"
 	strictLocations = map[string]bool{}
-	expirySess      = 60
+	expirySess      = DefaultServiceCodeExpiry
 )
 
 // ServiceCode contains all data related to service code.
@@ -72,21 +69,18 @@ func (sc ServiceCode) IsOffer() bool {
 	return sc.offerID != "" && sc.channel != ""
 }
 
-func init() {
-	sccStrictLocs := os.Getenv("SCC_STRICT_LOCS")
-	sccExp := os.Getenv("SCC_EXP_SEC")
-
-	if sccStrictLocs != "" {
-		locs := strings.Split(sccStrictLocs, ";")
-		for _, loc := range locs {
-			strictLocations[loc] = true
-		}
+// ConfigureServiceCode set salt key, strict locations and session expiry in seconds of service code,
+// default kept for empty salt key and non positive expiry.
+func ConfigureServiceCode(saltKey string, strictLocs []string, expirySec int) {
+	if saltKey != "" {
+		nounceKey = saltKey
 	}
-
-	if sccExp != "" && regexp.MustCompile(`\d+`).MatchString(sccExp) {
-		parseInt, parseErr := strconv.Atoi(sccExp)
-		condutils.When(parseErr != nil, log.Println, parseErr)
-		expirySess = condutils.Or(parseInt, expirySess).(int)
+	strictLocations = map[string]bool{}
+	for _, loc := range strictLocs {
+		strictLocations[loc] = true
+	}
+	if expirySec > 0 {
+		expirySess = expirySec
 	}
 }
 
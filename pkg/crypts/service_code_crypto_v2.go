@@ -6,14 +6,13 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"errors"
-	"os"
 	"strings"
 	"time"
 )
 
-// Contains env var to enable crypto.
+// Contains flag to enable crypto, set from `crypto.service_code` config.
 var (
-	ServiceCodeCryptoEnabled = os.Getenv("SERVICE_CODE_CRYPTO")
+	ServiceCodeCryptoEnabled string
 )
 
 func unpackNonEncrypted(compactCode string) (sid, ofid, channel, location string) {
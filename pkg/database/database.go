@@ -3,6 +3,7 @@ package models
 import (
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/postgres" //postgres database driver
@@ -28,3 +29,38 @@ func NewDBConnection(config map[string]map[string]interface{}) IServerDB {
 	}
 	panic(errors.New("config not found"))
 }
+
+// DBChecker check connection of database configured on `gorm` entry, connected on first check.
+type DBChecker struct {
+	dialect          string
+	connectionString string
+
+	mu sync.Mutex
+	db *gorm.DB
+}
+
+// NewDBChecker checker of database on `config`, nil when no database configured.
+func NewDBChecker(config map[string]map[string]interface{}) *DBChecker {
+	c, f := config["gorm"]
+	if !f {
+		return nil
+	}
+	dialect, _ := c["dialect"].(string)
+	connectionString, _ := c["connectionstring"].(string)
+	return &DBChecker{dialect: dialect, connectionString: connectionString}
+}
+
+// Ping connect when not connected yet then ping database, connection kept for next check.
+func (c *DBChecker) Ping() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.db == nil {
+		connection, err := gorm.Open(c.dialect, c.connectionString)
+		if err != nil {
+			return fmt.Errorf("cannot connect to %s database: %v", c.dialect, err)
+		}
+		c.db = connection
+	}
+	return c.db.DB().Ping()
+}
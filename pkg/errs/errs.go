@@ -2,15 +2,15 @@ package errs
 
 import (
 	"fmt"
-	"os"
 	"strconv"
 )
 
+// Default error title and description, set from `errors` config entry.
 var (
-	DefaultErrTitle   = os.Getenv("DEFAULT_ERROR_TITLE_ID")
-	DefaultErrDesc    = os.Getenv("DEFAULT_ERROR_DESCRIPTION_ID")
-	DefaultErrTitleEN = os.Getenv("DEFAULT_ERROR_TITLE_EN")
-	DefaultErrDescEN  = os.Getenv("DEFAULT_ERROR_DESCRIPTION_EN")
+	DefaultErrTitle   string
+	DefaultErrDesc    string
+	DefaultErrTitleEN string
+	DefaultErrDescEN  string
 )
 
 // Errs implementation of `errs.Erss`.
@@ -18,6 +18,7 @@ type Errs struct {
 	Code          string                 `json:"code"`
 	Status        string                 `json:"status"`
 	Message       string                 `json:"message"`
+	Errors        []FieldError           `json:"errors,omitempty"`
 	HttpCode      string                 `json:"-"`
 	OrigError     error                  `json:"-"`
 	Location      string                 `json:"-"`
@@ -32,6 +33,8 @@ type IErrs interface {
 	SetMessage(string) *Errs
 	SetError(error) *Errs
 	SetLocation() *Errs
+	SetLang(string) *Errs
+	SetFieldErrors([]FieldError) *Errs
 	Log(func(...interface{})) *Errs
 	GetData() *Errs
 	Error() string
@@ -75,6 +78,19 @@ func (e *Errs) SetLocation() *Errs {
 	return e
 }
 
+// SetLang set language of error message.
+func (e *Errs) SetLang(lang string) *Errs {
+	e.Lang = lang
+	return e
+}
+
+// SetFieldErrors set failed validation of request fields, message set to title on error language.
+func (e *Errs) SetFieldErrors(fields []FieldError) *Errs {
+	e.Errors = fields
+	e.Message = Title(e.Lang)
+	return e
+}
+
 // Log do logging of this error.
 func (e *Errs) Log(logger func(...interface{})) *Errs {
 	logger(e)
@@ -0,0 +1,155 @@
+package errs
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// LangID indonesian error message.
+	LangID = "id"
+	// LangEN english error message, used when client accept no supported language.
+	LangEN = "en"
+)
+
+// FieldError failed validation rule of single request field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+}
+
+var (
+	titles = map[string]string{
+		LangID: "Validasi Gagal",
+		LangEN: "Validation Failed",
+	}
+
+	// fieldMessages message of validation rule by language, formatted with field name and rule param.
+	// Rule suffixed `_len` used when rule constraint length of string or count of items.
+	fieldMessages = map[string]map[string]string{
+		LangID: {
+			"required":         "{field} wajib diisi",
+			"required_if":      "{field} wajib diisi bila {param}",
+			"required_without": "{field} wajib diisi bila {param} kosong",
+			"min":              "{field} minimal {param}",
+			"min_len":          "{field} minimal berisi {param} karakter/item",
+			"max":              "{field} maksimal {param}",
+			"max_len":          "{field} maksimal berisi {param} karakter/item",
+			"len":              "{field} harus {param}",
+			"len_len":          "{field} harus berisi {param} karakter/item",
+			"gte":              "{field} harus lebih besar atau sama dengan {param}",
+			"gte_len":          "{field} minimal berisi {param} karakter/item",
+			"gt":               "{field} harus lebih besar dari {param}",
+			"gt_len":           "{field} harus berisi lebih dari {param} karakter/item",
+			"lte":              "{field} harus lebih kecil atau sama dengan {param}",
+			"lte_len":          "{field} maksimal berisi {param} karakter/item",
+			"lt":               "{field} harus lebih kecil dari {param}",
+			"lt_len":           "{field} harus berisi kurang dari {param} karakter/item",
+			"gtfield":          "{field} harus lebih besar dari {param}",
+			"oneof":            "{field} harus salah satu dari [{param}]",
+			"numeric":          "{field} harus berupa angka",
+			"alphanum":         "{field} hanya boleh berisi huruf dan angka",
+			"alpha_or_numeric": "{field} hanya boleh berisi huruf dan angka",
+			"email":            "{field} harus berupa alamat email",
+			"url":              "{field} harus berupa URL",
+			"uuid":             "{field} harus berupa UUID",
+			"datetime":         "{field} harus berupa waktu RFC 3339",
+			"":                 "{field} tidak valid",
+		},
+		LangEN: {
+			"required":         "{field} is required",
+			"required_if":      "{field} is required when {param}",
+			"required_without": "{field} is required when {param} is empty",
+			"min":              "{field} must be at least {param}",
+			"min_len":          "{field} must contain at least {param} characters/items",
+			"max":              "{field} must be at most {param}",
+			"max_len":          "{field} must contain at most {param} characters/items",
+			"len":              "{field} must be {param}",
+			"len_len":          "{field} must contain exactly {param} characters/items",
+			"gte":              "{field} must be greater than or equal to {param}",
+			"gte_len":          "{field} must contain at least {param} characters/items",
+			"gt":               "{field} must be greater than {param}",
+			"gt_len":           "{field} must contain more than {param} characters/items",
+			"lte":              "{field} must be less than or equal to {param}",
+			"lte_len":          "{field} must contain at most {param} characters/items",
+			"lt":               "{field} must be less than {param}",
+			"lt_len":           "{field} must contain less than {param} characters/items",
+			"gtfield":          "{field} must be greater than {param}",
+			"oneof":            "{field} must be one of [{param}]",
+			"numeric":          "{field} must be a number",
+			"alphanum":         "{field} may only contain letters and numbers",
+			"alpha_or_numeric": "{field} may only contain letters and numbers",
+			"email":            "{field} must be an email address",
+			"url":              "{field} must be an URL",
+			"uuid":             "{field} must be an UUID",
+			"datetime":         "{field} must be RFC 3339 time",
+			"":                 "{field} is invalid",
+		},
+	}
+)
+
+// ParseLang language of error message asked by `Accept-Language` header, highest quality supported language win.
+func ParseLang(acceptLanguage string) string {
+	type candidate struct {
+		lang    string
+		quality float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		lang := strings.ToLower(strings.SplitN(strings.TrimSpace(fields[0]), "-", 2)[0])
+		if _, ok := titles[lang]; !ok {
+			continue
+		}
+		quality := 1.0
+		for _, param := range fields[1:] {
+			if q := strings.TrimSpace(param); strings.HasPrefix(q, "q=") {
+				if v, err := strconv.ParseFloat(q[2:], 64); err == nil {
+					quality = v
+				}
+			}
+		}
+		if quality > 0 {
+			candidates = append(candidates, candidate{lang, quality})
+		}
+	}
+	if len(candidates) == 0 {
+		return LangEN
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].quality > candidates[j].quality })
+	return candidates[0].lang
+}
+
+// Title title of validation error on `lang`, overridden by `DEFAULT_ERROR_TITLE_ID` / `DEFAULT_ERROR_TITLE_EN`.
+func Title(lang string) string {
+	if lang == LangID && DefaultErrTitle != "" {
+		return DefaultErrTitle
+	}
+	if lang == LangEN && DefaultErrTitleEN != "" {
+		return DefaultErrTitleEN
+	}
+	if title, ok := titles[lang]; ok {
+		return title
+	}
+	return titles[LangEN]
+}
+
+// FieldMessage message of `field` failed on validation `rule` with `param` on `lang`.
+// `length` tell rule constraint length of string or count of items instead of value.
+func FieldMessage(lang, field, rule, param string, length bool) string {
+	messages, ok := fieldMessages[lang]
+	if !ok {
+		messages = fieldMessages[LangEN]
+	}
+	template, ok := messages[rule+"_len"]
+	if !ok || !length {
+		template, ok = messages[rule]
+	}
+	if !ok {
+		template = messages[""]
+	}
+	return strings.NewReplacer("{field}", field, "{param}", param).Replace(template)
+}
@@ -0,0 +1,38 @@
+package errs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LangSuite struct {
+	suite.Suite
+}
+
+func (ls *LangSuite) TestParseLang() {
+	ls.Equal(LangEN, ParseLang(""), "no header must fallback to english")
+	ls.Equal(LangID, ParseLang("id-ID,id;q=0.9,en;q=0.8"))
+	ls.Equal(LangEN, ParseLang("fr-FR, id;q=0.5, en-US;q=0.8"), "highest quality supported language must win")
+	ls.Equal(LangEN, ParseLang("id;q=0, en"), "language with zero quality must be refused")
+	ls.Equal(LangEN, ParseLang("de"))
+}
+
+func (ls *LangSuite) TestFieldMessage() {
+	ls.Equal("name is required", FieldMessage(LangEN, "name", "required", "", false))
+	ls.Equal("name wajib diisi", FieldMessage(LangID, "name", "required", "", false))
+	ls.Equal("limit must be greater than or equal to 0", FieldMessage(LangEN, "limit", "gte", "0", false))
+	ls.Equal("name must contain at least 3 characters/items", FieldMessage(LangEN, "name", "min", "3", true))
+	ls.Equal("type harus salah satu dari [SUV MPV]", FieldMessage(LangID, "type", "oneof", "SUV MPV", false))
+	ls.Equal("code is invalid", FieldMessage("fr", "code", "unknown_rule", "", false), "unknown rule and language must fallback")
+}
+
+func (ls *LangSuite) TestSetFieldErrors() {
+	err := NewErrContext().SetCode(BadRequest).SetLang(LangID).SetFieldErrors([]FieldError{{Field: "name", Rule: "required"}})
+	ls.Equal("Validasi Gagal", err.Message, "message must be title on error language")
+	ls.Len(err.Errors, 1)
+}
+
+func TestLangSuite(t *testing.T) {
+	suite.Run(t, new(LangSuite))
+}
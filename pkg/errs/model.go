@@ -1,12 +1,10 @@
 package errs
 
-import "os"
-
 var (
-	// ErrorDebugLocation to include error location.
-	ErrorDebugLocation = os.Getenv("ERROR_DEBUG_LOCATION")
-	// MaskingErrorMessage to masking error message passing to client.
-	MaskingErrorMessage = os.Getenv("MASKING_ERROR_MESSAGE")
+	// ErrorDebugLocation to include error location when `1`, set from `errors.debug_location` config.
+	ErrorDebugLocation string
+	// MaskingErrorMessage to masking error message passing to client when `1`, set from `errors.masking_message` config.
+	MaskingErrorMessage string
 )
 
 const (
@@ -128,6 +126,12 @@ const (
 
 	// Conflict response
 	Conflict = 409
+
+	// ServiceUnavailable response when service shutting down.
+	ServiceUnavailable = 503
+
+	// PreconditionRequired response when update sent without expected version.
+	PreconditionRequired = 428
 )
 
 var (
@@ -165,5 +169,6 @@ var (
 		"411": "REDIS_ERROR",
 		"412": "DATABASE_ERROR",
 		"500": "INTERNAL_SERVER_ERROR",
+		"503": "SERVICE_UNAVAILABLE",
 	}
 )
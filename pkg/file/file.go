@@ -5,4 +5,6 @@ type IFileSystem interface {
 	SaveData(nameFile string, data interface{}) (bool, error)
 	IsFileExisting(nameFile string) bool
 	LoadFile(fileName string) ([]byte, error)
+	// Lock lock `tables` for read-modify-write until returned func called.
+	Lock(tables ...string) func()
 }
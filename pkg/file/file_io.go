@@ -6,8 +6,35 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/metrics"
+)
+
+const (
+	operationLoad = "load"
+	operationSave = "save"
+)
+
+var (
+	storageDuration = metrics.Default.NewHistogram("storage_operation_duration_seconds",
+		"Time taken to load or save json table.", nil, "operation", "table")
+	storageErrors = metrics.Default.NewCounter("storage_operation_errors_total",
+		"Failed load or save of json table.", "operation", "table")
+	storageFileSize = metrics.Default.NewGauge("storage_file_size_bytes",
+		"Size of json table on last load or save.", "table")
 )
 
+// observeStorage record duration of `operation` on `table` started at `start`, with file size when succeed.
+func observeStorage(operation, table string, start time.Time, size int, err error) {
+	storageDuration.Observe(time.Since(start).Seconds(), operation, table)
+	if err != nil {
+		storageErrors.Inc(operation, table)
+		return
+	}
+	storageFileSize.Set(float64(size), table)
+}
+
 type fileSystem struct {
 	path string
 }
@@ -37,28 +64,88 @@ func (fs *fileSystem) CreateFile(nameFile string) (path *string, errors error) {
 		return nil, err
 	}
 	defer f.Close()
+
+	// every table stored as json array, start with empty one so it can be loaded right away
+	if _, err := f.WriteString("[]"); err != nil {
+		return nil, err
+	}
 	return &filePath, nil
 }
 
-func (fs *fileSystem) SaveData(nameFile string, data interface{}) (bool, error) {
+func (fs *fileSystem) SaveData(nameFile string, data interface{}) (saved bool, err error) {
+	start := time.Now()
+	var jsonData []byte
+	defer func() { observeStorage(operationSave, nameFile, start, len(jsonData), err) }()
+
 	path := fs.path + nameFile + ".json"
-	jsonData, err := json.MarshalIndent(data, "", "  ")
+	jsonData, err = json.MarshalIndent(data, "", "  ")
 	if err != nil {
 		return false, err
 	}
-	err = os.WriteFile(path, jsonData, 0644)
+	err = writeAtomic(path, jsonData)
 	if err != nil {
 		return false, err
 	}
 	return true, nil
 }
+
+// writeAtomic write `data` to temporary file then rename it over `path`,
+// so write interrupted by shutdown never leave table truncated.
+func writeAtomic(path string, data []byte) (err error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(tmp.Name())
+		}
+	}()
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// SyncStorage flush every table of storage on `path` to disk, run on shutdown after in-flight writes drained.
+func SyncStorage(path string) error {
+	tables, err := filepath.Glob(filepath.Join(path, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, table := range append(tables, path) {
+		if err := syncFile(table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func syncFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return f.Sync()
+}
 func (fs *fileSystem) IsFileExisting(nameFile string) bool {
 	path := fs.path + nameFile + ".json"
 	_, err := os.Stat(path)
 	return !os.IsNotExist(err)
 }
 
-func (fs *fileSystem) LoadFile(fileName string) ([]byte, error) {
+func (fs *fileSystem) LoadFile(fileName string) (byteValue []byte, err error) {
+	start := time.Now()
+	defer func() { observeStorage(operationLoad, fileName, start, len(byteValue), err) }()
+
 	filePath := fs.path + fileName + ".json"
 
 	jsonFile, err := os.Open(filePath)
@@ -67,7 +154,7 @@ func (fs *fileSystem) LoadFile(fileName string) ([]byte, error) {
 	}
 	defer jsonFile.Close()
 
-	byteValue, err := io.ReadAll(jsonFile)
+	byteValue, err = io.ReadAll(jsonFile)
 
 	if err != nil {
 		return nil, err
@@ -0,0 +1,69 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// tableLocks mutex per table file, shared by every file system of same storage path.
+var tableLocks = struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}{locks: map[string]*sync.Mutex{}}
+
+func tableLock(path string) *sync.Mutex {
+	tableLocks.mu.Lock()
+	defer tableLocks.mu.Unlock()
+	l, ok := tableLocks.locks[path]
+	if !ok {
+		l = &sync.Mutex{}
+		tableLocks.locks[path] = l
+	}
+	return l
+}
+
+// Lock lock `tables` until returned func called, held from load through save so concurrent writers never overwrite
+// each other. Tables locked in name order so writers locking several tables never deadlock, every table needed
+// by a writer must be locked on single call.
+// Lock also held on `<table>.lock` file of storage, so writers of other process, eg: admin command run while
+// server serving, wait for it too.
+func (fs *fileSystem) Lock(tables ...string) func() {
+	paths := make([]string, 0, len(tables))
+	seen := map[string]bool{}
+	for _, t := range tables {
+		path := filepath.Clean(fs.path + t)
+		if !seen[path] {
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	if err := os.MkdirAll(fs.path, os.ModePerm); err != nil {
+		panic(err)
+	}
+	unlocks := make([]func(), 0, len(paths))
+	for _, path := range paths {
+		l := tableLock(path)
+		l.Lock()
+		unlockFile, err := lockFile(path + ".lock")
+		if err != nil {
+			l.Unlock()
+			for i := len(unlocks) - 1; i >= 0; i-- {
+				unlocks[i]()
+			}
+			panic(err)
+		}
+		unlocks = append(unlocks, func() {
+			unlockFile()
+			l.Unlock()
+		})
+	}
+	return func() {
+		for i := len(unlocks) - 1; i >= 0; i-- {
+			unlocks[i]()
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package file
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// envLockHelper storage path of helper process locking table `vehicle` then exiting.
+const envLockHelper = "PSM_TEST_LOCK_HELPER"
+
+type FileLockSuite struct {
+	suite.Suite
+}
+
+func (s *FileLockSuite) TestLockAcrossProcess() {
+	dir := s.T().TempDir() + "/"
+	unlock := NewFileSystem(dir).Lock("vehicle")
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestLockHelperProcess")
+	cmd.Env = append(os.Environ(), envLockHelper+"="+dir)
+	s.Require().NoError(cmd.Start())
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	select {
+	case <-done:
+		s.Fail("other process locked table still locked")
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	unlock()
+	select {
+	case err := <-done:
+		s.NoError(err)
+	case <-time.After(5 * time.Second):
+		cmd.Process.Kill()
+		s.Fail("other process never locked released table")
+	}
+}
+
+func (s *FileLockSuite) TestLockSeveralTables() {
+	fs := NewFileSystem(s.T().TempDir() + "/")
+	unlock := fs.Lock("zone", "floor", "zone")
+	unlock()
+	// released tables lockable again
+	fs.Lock("floor", "zone")()
+}
+
+// TestLockHelperProcess lock table of storage given by parent test, skipped when run directly.
+func TestLockHelperProcess(t *testing.T) {
+	dir := os.Getenv(envLockHelper)
+	if dir == "" {
+		t.Skip("helper process of TestLockAcrossProcess")
+	}
+	NewFileSystem(dir).Lock("vehicle")()
+}
+
+func TestFileLockSuite(t *testing.T) {
+	suite.Run(t, new(FileLockSuite))
+}
@@ -0,0 +1,29 @@
+//go:build windows
+// +build windows
+
+package file
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+// lockRetry wait between attempts to create lock file held by other process.
+const lockRetry = 10 * time.Millisecond
+
+// lockFile hold `path` created exclusively until returned func called, removed when released.
+// Lock file left by crashed process must be removed by hand.
+func lockFile(path string) (func(), error) {
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_RDWR, 0644)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(path) }, nil
+		}
+		if !os.IsExist(err) && err != syscall.ERROR_ACCESS_DENIED {
+			return nil, err
+		}
+		time.Sleep(lockRetry)
+	}
+}
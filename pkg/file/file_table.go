@@ -0,0 +1,36 @@
+package file
+
+import (
+	"encoding/json"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+)
+
+// LoadTable load json table `tableName` of `fs` into `data`, table file created when not exists.
+func LoadTable(fs IFileSystem, tableName string, data interface{}) *errs.Errs {
+	if !fs.IsFileExisting(tableName) {
+		_, errCreate := fs.CreateFile(tableName)
+		if errCreate != nil {
+			return errs.NewErrContext().
+				SetCode(errs.InternalServerError).
+				SetMessage(errCreate.Error())
+		}
+		return nil
+	}
+
+	buff, errloadData := fs.LoadFile(tableName)
+	if errloadData != nil {
+		return errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(errloadData.Error())
+	}
+	if len(buff) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(buff, data); err != nil {
+		return errs.NewErrContext().
+			SetCode(errs.InternalServerError).
+			SetMessage(err.Error())
+	}
+	return nil
+}
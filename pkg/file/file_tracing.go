@@ -0,0 +1,68 @@
+package file
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/tracing"
+)
+
+// tracedFileSystem file system recording every operation as child span of span on `ctx`.
+type tracedFileSystem struct {
+	fs  IFileSystem
+	ctx context.Context
+}
+
+// WithTracing wrap `fs` so every operation traced as child of span on `ctx`, `fs` returned as is when nil.
+func WithTracing(fs IFileSystem, ctx context.Context) IFileSystem {
+	if fs == nil {
+		return nil
+	}
+	if traced, ok := fs.(*tracedFileSystem); ok {
+		fs = traced.fs
+	}
+	return &tracedFileSystem{fs: fs, ctx: ctx}
+}
+
+func (t *tracedFileSystem) start(name, table string) *tracing.Span {
+	_, span := tracing.Start(t.ctx, name)
+	span.SetAttribute("table", table)
+	return span
+}
+
+func (t *tracedFileSystem) CreateFile(nameFile string) (*string, error) {
+	span := t.start("file.CreateFile", nameFile)
+	defer span.End()
+	path, err := t.fs.CreateFile(nameFile)
+	span.RecordError(err)
+	return path, err
+}
+
+func (t *tracedFileSystem) SaveData(nameFile string, data interface{}) (bool, error) {
+	span := t.start("file.SaveData", nameFile)
+	defer span.End()
+	saved, err := t.fs.SaveData(nameFile, data)
+	span.RecordError(err)
+	return saved, err
+}
+
+func (t *tracedFileSystem) IsFileExisting(nameFile string) bool {
+	span := t.start("file.IsFileExisting", nameFile)
+	defer span.End()
+	return t.fs.IsFileExisting(nameFile)
+}
+
+func (t *tracedFileSystem) LoadFile(fileName string) ([]byte, error) {
+	span := t.start("file.LoadFile", fileName)
+	defer span.End()
+	byteValue, err := t.fs.LoadFile(fileName)
+	span.RecordError(err)
+	span.SetAttribute("size", len(byteValue))
+	return byteValue, err
+}
+
+func (t *tracedFileSystem) Lock(tables ...string) func() {
+	span := t.start("file.Lock", strings.Join(tables, ","))
+	defer span.End()
+	return t.fs.Lock(tables...)
+}
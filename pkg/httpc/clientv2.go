@@ -9,6 +9,7 @@ import (
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
 	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
 	"github.com/mhaikalla/parking-service-management-library/pkg/logs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/tracing"
 )
 
 const (
@@ -61,9 +62,19 @@ func (ur *UpstreamsRequest) Request() (returnedCtx *UpstreamsRequest) {
 
 // RequestWithContext same like Request but using context provided
 func (ur *UpstreamsRequest) RequestWithContext(ctx context.Context) *UpstreamsRequest {
-	child, cancelFunc := context.WithCancel(ctx)
+	spanCtx, span := tracing.StartKind(ctx, "HTTP "+strings.ToUpper(ur.Method), tracing.SpanKindClient)
+	defer span.End()
+	span.SetAttribute("http.method", ur.Method)
+	span.SetAttribute("http.url", ur.URL)
+
+	child, cancelFunc := context.WithCancel(spanCtx)
 	defer cancelFunc()
 	defer func() {
+		span.SetAttribute("http.status_code", ur.statusCode)
+		if ur.requestError != nil {
+			span.RecordError(ur.requestError)
+		}
+
 		condutils.When(ur.requestError != nil, ur.defaultLogger.Upsert, "error", ur.requestError)
 		ur.defaultLogger.Upsert(logs.LogType, "OUTSIDE")
 		ur.defaultLogger.Update()
@@ -1,12 +1,15 @@
 package httpc
 
 import (
+	"context"
 	"math"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/mhaikalla/parking-service-management-library/pkg/tracing"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
@@ -65,6 +68,32 @@ func (urs *UpstreamsRequestSuite) TestSuccessOnGetMethod() {
 	urs.True(res.IsSuccess(), "response must success")
 }
 
+func (urs *UpstreamsRequestSuite) TestPropagateTraceParent() {
+	ctx, span := tracing.Start(context.Background(), "UsecaseParking.SetParkingIn")
+	defer span.End()
+
+	var traceParent string
+	handler := func(rw http.ResponseWriter, req *http.Request) {
+		traceParent = req.Header.Get(tracing.HeaderTraceParent)
+		urs.NoError(respFunc(rw, 200, []byte(`{}`), "json"))
+	}
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	req := UpstreamsRequest{
+		URL:          server.URL,
+		Method:       "GET",
+		Client:       &http.Client{Timeout: time.Second * 5},
+		SuccessCodes: []int{200},
+	}
+	req.RequestWithContext(ctx)
+
+	sc, err := tracing.ParseTraceParent(traceParent)
+	urs.NoError(err, "upstream must receive traceparent")
+	urs.Equal(span.SpanContext().TraceID, sc.TraceID)
+	urs.NotEqual(span.SpanContext().SpanID, sc.SpanID, "upstream parent must be client span")
+}
+
 func (urs *UpstreamsRequestSuite) TestErrorOnParsingPayload() {
 
 	handler := func(rw http.ResponseWriter, req *http.Request) {
@@ -15,6 +15,7 @@ import (
 
 	"github.com/mhaikalla/parking-service-management-library/pkg/condutils"
 	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/tracing"
 )
 
 // parseBodyPayload parse body payload based object type, return reader, length of payload, and an error.
@@ -96,6 +97,9 @@ func createRequest(ur *UpstreamsRequest, ctx context.Context) (*http.Request, er
 		}
 	}
 
+	// continue trace of caller on upstream
+	tracing.Inject(ctx, req.Header)
+
 	if err := attachAuthHeader(req.Header, ur.BearerFn, ur.Bearer); err != nil {
 		return nil, errs.NewErrContext().SetCode(errs.HTTPClientRequestErr).SetError(err)
 	}
@@ -0,0 +1,110 @@
+package idempotency
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+)
+
+// TableName json table records stored on.
+const TableName = "idempotency_key"
+
+// FileStore keep records on json table of storage layer, expired records pruned on every write.
+type FileStore struct {
+	mu sync.Mutex
+	fs file.IFileSystem
+}
+
+// NewFileStore create store on `fs`.
+func NewFileStore(fs file.IFileSystem) *FileStore {
+	return &FileStore{fs: fs}
+}
+
+// Reserve save reservation when key unused or expired, otherwise return stored record.
+func (s *FileStore) Reserve(rec Record, now time.Time) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	kept := records[:0]
+	for _, r := range records {
+		if r.IsExpired(now) {
+			continue
+		}
+		if r.Key == rec.Key {
+			found := r
+			return &found, nil
+		}
+		kept = append(kept, r)
+	}
+	return nil, s.save(append(kept, rec))
+}
+
+// Complete replace reservation of `rec.Key` with `rec`.
+func (s *FileStore) Complete(rec Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records, err := s.load()
+	if err != nil {
+		return err
+	}
+	for i, r := range records {
+		if r.Key == rec.Key {
+			records[i] = rec
+			return s.save(records)
+		}
+	}
+	return s.save(append(records, rec))
+}
+
+// Release drop record of `key`.
+func (s *FileStore) Release(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records, err := s.load()
+	if err != nil {
+		return err
+	}
+	kept := records[:0]
+	for _, r := range records {
+		if r.Key != key {
+			kept = append(kept, r)
+		}
+	}
+	return s.save(kept)
+}
+
+func (s *FileStore) load() ([]Record, error) {
+	records := []Record{}
+	if !s.fs.IsFileExisting(TableName) {
+		if _, err := s.fs.CreateFile(TableName); err != nil {
+			return nil, err
+		}
+		return records, nil
+	}
+	buff, err := s.fs.LoadFile(TableName)
+	if err != nil {
+		return nil, err
+	}
+	if len(buff) == 0 {
+		return records, nil
+	}
+	if err := json.Unmarshal(buff, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (s *FileStore) save(records []Record) error {
+	if _, err := s.fs.SaveData(TableName, records); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+// Package idempotency store first response of request sent with `Idempotency-Key` header so retry can be replayed.
+package idempotency
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// Record response stored for an idempotency key.
+// Record not completed yet is a reservation of request still in progress.
+type Record struct {
+	Key         string    `json:"key"`
+	RequestHash string    `json:"request_hash"`
+	Completed   bool      `json:"completed"`
+	StatusCode  int       `json:"status_code"`
+	ContentType string    `json:"content_type"`
+	Body        []byte    `json:"body"`
+	CreatedAt   time.Time `json:"created_at"`
+	ExpiredAt   time.Time `json:"expired_at"`
+}
+
+// IsExpired check whether record can be forgotten at `now`.
+func (r Record) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiredAt)
+}
+
+// Store keep idempotency records, implementation must be safe for concurrent use.
+type Store interface {
+	// Reserve save `rec` as reservation when its key unused or expired, otherwise return record already stored.
+	Reserve(rec Record, now time.Time) (*Record, error)
+	// Complete replace reservation with completed record.
+	Complete(rec Record) error
+	// Release drop reservation of `key` so request can be retried.
+	Release(key string) error
+}
+
+// Hash hex sha256 of `parts`, used for storage key and request fingerprint.
+func Hash(parts ...[]byte) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write(p)
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,66 @@
+package idempotency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type IdempotencyTestSuite struct {
+	suite.Suite
+	store *FileStore
+	now   time.Time
+}
+
+func (s *IdempotencyTestSuite) SetupTest() {
+	s.store = NewFileStore(file.NewFileSystem(s.T().TempDir() + "/"))
+	s.now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *IdempotencyTestSuite) TestReserveAndComplete() {
+	rec := Record{Key: "k1", RequestHash: "h1", CreatedAt: s.now, ExpiredAt: s.now.Add(time.Minute)}
+
+	stored, err := s.store.Reserve(rec, s.now)
+	s.NoError(err)
+	s.Nil(stored, "unused key must be reserved")
+
+	stored, err = s.store.Reserve(rec, s.now)
+	s.NoError(err)
+	s.NotNil(stored, "reserved key must be returned")
+	s.False(stored.Completed, "reservation not completed yet")
+
+	rec.Completed = true
+	rec.StatusCode = 201
+	rec.Body = []byte(`{"message":"Success"}`)
+	rec.ExpiredAt = s.now.Add(time.Hour)
+	s.NoError(s.store.Complete(rec))
+
+	stored, _ = s.store.Reserve(rec, s.now.Add(30*time.Minute))
+	s.NotNil(stored, "completed record must be returned")
+	s.Equal(201, stored.StatusCode)
+	s.Equal(`{"message":"Success"}`, string(stored.Body), "stored body must be kept")
+
+	stored, _ = s.store.Reserve(rec, s.now.Add(2*time.Hour))
+	s.Nil(stored, "expired key can be reserved again")
+}
+
+func (s *IdempotencyTestSuite) TestRelease() {
+	rec := Record{Key: "k1", RequestHash: "h1", CreatedAt: s.now, ExpiredAt: s.now.Add(time.Minute)}
+	_, _ = s.store.Reserve(rec, s.now)
+	s.NoError(s.store.Release("k1"))
+
+	stored, _ := s.store.Reserve(rec, s.now)
+	s.Nil(stored, "released key can be reserved again")
+}
+
+func (s *IdempotencyTestSuite) TestHash() {
+	s.Equal(Hash([]byte("a"), []byte("b")), Hash([]byte("a"), []byte("b")), "hash must be stable")
+	s.NotEqual(Hash([]byte("ab"), []byte("")), Hash([]byte("a"), []byte("b")), "parts must be separated")
+}
+
+func TestIdempotencySuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyTestSuite))
+}
@@ -7,7 +7,6 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
-	"os"
 	"strconv"
 
 	"github.com/mhaikalla/parking-service-management-library/pkg/condutils"
@@ -21,7 +20,8 @@ import (
 	"golang.org/x/crypto/pbkdf2"
 )
 
-var CiamEnabledStatus = os.Getenv("CIAM_ENABLED_STATUS")
+// CiamEnabledStatus CIAM token accepted when `true`, set from `jwt.ciam_enabled` config.
+var CiamEnabledStatus string
 
 // deriveKey ...
 func deriveKey(key string, salt interface{}, length int) []byte {
@@ -69,6 +69,14 @@ func verifyWithKey(buff []byte, signMethod jwa.SignatureAlgorithm, key interface
 	return jws.Verify(buff, signMethod, key)
 }
 
+// encryptionKey public part of key used to encrypt JWE, symmetric key returned as is
+func encryptionKey(key interface{}) interface{} {
+	if privKey, ok := key.(*rsa.PrivateKey); ok {
+		return &privKey.PublicKey
+	}
+	return key
+}
+
 // defaultAssertFn ...
 func defaultAssertFn(claims JWTClaims) error {
 
@@ -107,55 +115,47 @@ func defaultAssertFn(claims JWTClaims) error {
 }
 
 // parseJWEConfig ...
-func parseJWEConfig(config, secret map[string]interface{}) JWT {
+func parseJWEConfig(config Config, secret Secret) JWT {
 	res := JWT{}
 
-	encryptionMethod := config["encryption_method"].(string)
-	compressionMethod := config["compression_method"].(string)
-	key := secret["key"].(string)
-	salt := secret["salt"]
-
 	var encMethod jwa.ContentEncryptionAlgorithm
 	var keyEncMethod jwa.KeyEncryptionAlgorithm
 	var derivedKey interface{}
 
-	if !condutils.IsEmpty(config["key_algo"]) {
-		encMethod = jwa.ContentEncryptionAlgorithm(encryptionMethod)
-		keyEncMethod = jwa.KeyEncryptionAlgorithm(config["key_algo"].(string))
-		derivedKey = parseRSAPrivKeyString(key)
+	if config.KeyAlgo != "" {
+		encMethod = jwa.ContentEncryptionAlgorithm(config.EncryptionMethod)
+		keyEncMethod = jwa.KeyEncryptionAlgorithm(config.KeyAlgo)
+		derivedKey = parseRSAPrivKeyString(secret.Key)
 	} else {
-		encMethod, keyEncMethod, derivedKey = EncryptionMethodPairs(encryptionMethod, key, salt)
+		encMethod, keyEncMethod, derivedKey = EncryptionMethodPairs(config.EncryptionMethod, secret.Key, secret.Salt)
 	}
 
 	res.isJWE = true
 	res.encryptionMethod = encMethod
 	res.keyEncryptionMethod = keyEncMethod
 	res.key = derivedKey
-	res.compressionMethod = CompressionMethodSelection(compressionMethod)
-	res.enable = config["enable"].(bool)
-	res.duration = config["duration"].(int)
+	res.compressionMethod = CompressionMethodSelection(config.CompressionMethod)
+	res.enable = config.Enable
+	res.duration = config.Duration
 	res.assertFn = defaultAssertFn
 
 	return res
 }
 
 // parseJWSConfig ...
-func parseJWSConfig(config, secret map[string]interface{}) JWT {
+func parseJWSConfig(config Config, secret Secret) JWT {
 	res := JWT{}
 
-	signingMethod := config["signing_method"].(string)
-	key := secret["key"].(string)
-
-	if isPrivateKey(key) {
-		res.key = parseRSAPrivKeyString(key)
+	if isPrivateKey(secret.Key) {
+		res.key = parseRSAPrivKeyString(secret.Key)
 	} else {
-		res.key = []byte(key)
+		res.key = []byte(secret.Key)
 	}
 
 	res.isJWE = false
-	res.signingMethod = SigningMethodSelection(signingMethod)
-	res.enable = config["enable"].(bool)
-	res.duration = config["duration"].(int)
+	res.signingMethod = SigningMethodSelection(config.SigningMethod)
+	res.enable = config.Enable
+	res.duration = config.Duration
 	res.assertFn = defaultAssertFn
 
 	return res
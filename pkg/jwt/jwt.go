@@ -3,6 +3,7 @@ package jwt
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"time"
 
@@ -33,6 +34,14 @@ func (j *JWT) serializeClaims(claims interface{}) (string, error) {
 		return "", err
 	}
 
+	if j.isJWE {
+		enc, err := jwe.Encrypt(buff, j.keyEncryptionMethod, encryptionKey(j.key), j.encryptionMethod, j.compressionMethod)
+		if err != nil {
+			return "", err
+		}
+		return string(enc), nil
+	}
+
 	sig, err := jws.Sign(buff, j.signingMethod, j.key)
 
 	if err != nil {
@@ -72,7 +81,11 @@ func (j *JWT) Serialize(compactedJWT string) (JWTClaims, error) {
 	var buff []byte
 	var err error
 
-	buff, err = verifyWithKey([]byte(compactedJWT), j.signingMethod, j.key)
+	if j.isJWE {
+		buff, err = jwe.Decrypt([]byte(compactedJWT), j.keyEncryptionMethod, j.key)
+	} else {
+		buff, err = verifyWithKey([]byte(compactedJWT), j.signingMethod, j.key)
+	}
 
 	if err := json.Unmarshal(buff, &res); err != nil {
 		return res, err
@@ -107,31 +120,70 @@ func (j *JWT) Assert(claims JWTClaims) error {
 	return j.assertFn(claims)
 }
 
-// NewJWT create a new jwt context
-func NewJWT(config map[string]map[string]interface{}) IJWT {
-
-	var ctx JWT
-
-	if conf, found := config["jwt"]; found {
+// Config `jwt` entry, JWE used when EncryptionMethod set otherwise JWS signed with SigningMethod.
+type Config struct {
+	Enable            bool   `mapstructure:"enable"`
+	SigningMethod     string `mapstructure:"signing_method" validate:"omitempty,oneof=HS256 HS384 HS512 ES256 ES384 ES512 RS256 RS384 RS512"`
+	EncryptionMethod  string `mapstructure:"encryption_method" validate:"omitempty,oneof=A128CBC-HS256 A192CBC-HS384 A256CBC-HS512 A128GCM A192GCM A256GCM"`
+	KeyAlgo           string `mapstructure:"key_algo" validate:"omitempty,oneof=RSA1_5 RSA-OAEP RSA-OAEP-256"`
+	CompressionMethod string `mapstructure:"compression_method" validate:"omitempty,oneof=none deflate"`
+	// Duration seconds access token valid.
+	Duration    int  `mapstructure:"duration" validate:"min=0"`
+	CiamEnabled bool `mapstructure:"ciam_enabled"`
+}
 
-		if secConf, found := config["secrets"]; found {
-			if secJWTConf, found := secConf["jwt"].(map[string]interface{}); found {
+// Secret `secrets.jwt` entry, RSA private key PEM or HMAC key, salt of derived JWE key.
+type Secret struct {
+	Key  string `mapstructure:"key"`
+	Salt string `mapstructure:"salt"`
+}
 
-				if _, found := conf["encryption_method"]; found {
-					ctx = parseJWEConfig(conf, secJWTConf)
-				} else {
-					ctx = parseJWSConfig(conf, secJWTConf)
-				}
+// New create a new jwt context of typed `conf` and `secret`, panic when key can not be parsed.
+func New(conf Config, secret Secret) IJWT {
+	var ctx JWT
+	if conf.EncryptionMethod != "" {
+		ctx = parseJWEConfig(conf, secret)
+	} else {
+		ctx = parseJWSConfig(conf, secret)
+	}
+	return &ctx
+}
 
-			} else {
-				panic(errors.New("No configuration key jwt found in secrets entry"))
-			}
-		} else {
-			panic(errors.New("No configuration key secrets found"))
+// Check error when `secret` can not be used with `conf`, eg: key not a PEM private key on RSA algorithm.
+func Check(conf Config, secret Secret) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
 		}
-	} else {
+	}()
+	New(conf, secret)
+	return nil
+}
+
+// NewJWT create a new jwt context from `jwt` and `secrets` entries of untyped config
+func NewJWT(config map[string]map[string]interface{}) IJWT {
+	conf, found := config["jwt"]
+	if !found {
 		panic(errors.New("No configuration key jwt found"))
 	}
+	secConf, found := config["secrets"]
+	if !found {
+		panic(errors.New("No configuration key secrets found"))
+	}
+	secJWTConf, found := secConf["jwt"].(map[string]interface{})
+	if !found {
+		panic(errors.New(JWTConfigurationNotFound))
+	}
 
-	return &ctx
+	typed := Config{
+		Enable:   conf["enable"].(bool),
+		Duration: conf["duration"].(int),
+	}
+	typed.SigningMethod, _ = conf["signing_method"].(string)
+	typed.EncryptionMethod, _ = conf["encryption_method"].(string)
+	typed.KeyAlgo, _ = conf["key_algo"].(string)
+	typed.CompressionMethod, _ = conf["compression_method"].(string)
+	secret := Secret{Key: secJWTConf["key"].(string)}
+	secret.Salt, _ = secJWTConf["salt"].(string)
+	return New(typed, secret)
 }
@@ -20,18 +20,22 @@ const HomeSatuSubsType = "HOMESATU"
 
 // JWTClaims standard claims for JWT
 type JWTClaims struct {
-	JWTID        string `json:"jti"`
-	Issuer       string `json:"iss"`
-	ClientID     string `json:"client_id"`
-	Subject      string `json:"sub"`
-	Audience     string `json:"aud"`
-	SessionID    string `json:"sid"`
-	IssuedAt     int64  `json:"iat,omitempty"`
-	ExpiredAt    int64  `json:"exp"`
-	MSISDN       string `json:"-"`
-	SubsID       string `json:"-"`
-	DeviceID     string `json:"-"`
-	IsFirstLogin string `json:"-"`
+	JWTID     string `json:"jti"`
+	Issuer    string `json:"iss"`
+	ClientID  string `json:"client_id"`
+	Subject   string `json:"sub"`
+	Audience  string `json:"aud"`
+	SessionID string `json:"sid"`
+	IssuedAt  int64  `json:"iat,omitempty"`
+	ExpiredAt int64  `json:"exp"`
+	SiteID    int    `json:"site_id,omitempty"`
+	// AllSites caller allowed on every site, otherwise only on SiteID (0 being default site).
+	AllSites     bool     `json:"all_sites,omitempty"`
+	Roles        []string `json:"roles,omitempty"`
+	MSISDN       string   `json:"-"`
+	SubsID       string   `json:"-"`
+	DeviceID     string   `json:"-"`
+	IsFirstLogin string   `json:"-"`
 }
 
 // CIAMClaims struct to hold information of claims got from CIAM
@@ -0,0 +1,219 @@
+// Package lifecycle track in-flight work so shutdown wait for it with deadline instead of guessing.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	// KindWrite request mutating storage.
+	KindWrite = "write"
+	// KindDelivery delivery of stored message to other service.
+	KindDelivery = "delivery"
+	// KindJob background job.
+	KindJob = "job"
+)
+
+// DefaultTimeout time shutdown wait in-flight work when not configured.
+const DefaultTimeout = 30 * time.Second
+
+// Signals signal asking service to shut down, SIGTERM sent by container runtime.
+var Signals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+// ErrDraining returned by `Begin` and `Go` once shutdown started.
+var ErrDraining = errors.New("service shutting down, no new work accepted")
+
+// Work unit of work tracked by manager.
+type Work struct {
+	Kind    string
+	Name    string
+	Started time.Time
+}
+
+// String kind, name and age of work.
+func (w Work) String() string {
+	return fmt.Sprintf("%s %s (running %s)", w.Kind, w.Name, time.Since(w.Started).Round(time.Millisecond))
+}
+
+// Report result of shutdown.
+type Report struct {
+	// Abandoned work still running when deadline passed.
+	Abandoned []Work
+	// Errors returned by stop and flush hooks.
+	Errors []error
+}
+
+type hook struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// Manager track in-flight work and run hooks on shutdown.
+type Manager struct {
+	mu       sync.Mutex
+	draining bool
+	nextID   uint64
+	inflight map[uint64]Work
+	changed  chan struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	stopHooks  []hook
+	flushHooks []hook
+}
+
+// New manager accepting work until shutdown.
+func New() *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{
+		inflight: map[uint64]Work{},
+		changed:  make(chan struct{}),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Context cancelled when shutdown start, background job stop on it.
+func (m *Manager) Context() context.Context {
+	return m.ctx
+}
+
+// Draining whether shutdown started.
+func (m *Manager) Draining() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.draining
+}
+
+// Begin track work `name` of `kind` until returned func called, ErrDraining once shutdown started.
+func (m *Manager) Begin(kind, name string) (func(), error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.draining {
+		return nil, ErrDraining
+	}
+	m.nextID++
+	id := m.nextID
+	m.inflight[id] = Work{Kind: kind, Name: name, Started: time.Now()}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			delete(m.inflight, id)
+			close(m.changed)
+			m.changed = make(chan struct{})
+		})
+	}, nil
+}
+
+// Go run background `job` tracked until it return, job must stop when `ctx` cancelled.
+func (m *Manager) Go(kind, name string, job func(ctx context.Context)) error {
+	done, err := m.Begin(kind, name)
+	if err != nil {
+		return err
+	}
+	go func() {
+		defer done()
+		job(m.ctx)
+	}()
+	return nil
+}
+
+// InFlight work still running, oldest first.
+func (m *Manager) InFlight() []Work {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.inFlight()
+}
+
+// inFlight caller must hold `mu`.
+func (m *Manager) inFlight() []Work {
+	works := make([]Work, 0, len(m.inflight))
+	for _, w := range m.inflight {
+		works = append(works, w)
+	}
+	sort.Slice(works, func(i, j int) bool { return works[i].Started.Before(works[j].Started) })
+	return works
+}
+
+// OnStop run `fn` when shutdown start to stop accepting work, eg: stop http server.
+// Hooks run in registering order, each bound to shutdown deadline.
+func (m *Manager) OnStop(name string, fn func(ctx context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stopHooks = append(m.stopHooks, hook{name, fn})
+}
+
+// OnFlush run `fn` after in-flight work drained or abandoned, eg: sync storage to disk.
+// Hooks run in registering order and always run, even when deadline passed.
+func (m *Manager) OnFlush(name string, fn func(ctx context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.flushHooks = append(m.flushHooks, hook{name, fn})
+}
+
+// Shutdown stop accepting work, wait in-flight work until `ctx` done, then flush.
+func (m *Manager) Shutdown(ctx context.Context) Report {
+	m.mu.Lock()
+	m.draining = true
+	stopHooks := append([]hook{}, m.stopHooks...)
+	flushHooks := append([]hook{}, m.flushHooks...)
+	m.mu.Unlock()
+	m.cancel()
+
+	report := Report{}
+	report.Errors = runHooks(ctx, stopHooks)
+
+wait:
+	for {
+		m.mu.Lock()
+		if len(m.inflight) == 0 {
+			m.mu.Unlock()
+			break
+		}
+		changed := m.changed
+		m.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			report.Abandoned = m.InFlight()
+			break wait
+		}
+	}
+
+	// flush not bound to drain deadline, abandoned work must not cost what already written
+	report.Errors = append(report.Errors, runHooks(context.Background(), flushHooks)...)
+	return report
+}
+
+func runHooks(ctx context.Context, hooks []hook) []error {
+	var errs []error
+	for _, h := range hooks {
+		if err := h.fn(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", h.name, err))
+		}
+	}
+	return errs
+}
+
+// NewTimeout shutdown deadline from `server.shutdown_timeout` config entry in seconds, DefaultTimeout when not set.
+func NewTimeout(config map[string]map[string]interface{}) time.Duration {
+	switch v := config["server"]["shutdown_timeout"].(type) {
+	case int:
+		return time.Duration(v) * time.Second
+	case float64:
+		return time.Duration(v * float64(time.Second))
+	}
+	return DefaultTimeout
+}
@@ -0,0 +1,94 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LifecycleSuite struct {
+	suite.Suite
+}
+
+func (ls *LifecycleSuite) TestDrainInFlight() {
+	m := New()
+	done, err := m.Begin(KindWrite, "POST /parking-in")
+	ls.NoError(err)
+
+	var mu sync.Mutex
+	var order []string
+	step := func(name string) {
+		mu.Lock()
+		defer mu.Unlock()
+		order = append(order, name)
+	}
+	m.OnStop("server", func(ctx context.Context) error {
+		step("stop")
+		return nil
+	})
+	m.OnFlush("storage", func(ctx context.Context) error {
+		step("flush")
+		return errors.New("disk full")
+	})
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		step("done")
+		done()
+		done()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	report := m.Shutdown(ctx)
+
+	ls.Empty(report.Abandoned)
+	ls.Equal([]string{"stop", "done", "flush"}, order, "flush run after in-flight work drained")
+	ls.Len(report.Errors, 1)
+	ls.EqualError(report.Errors[0], "storage: disk full")
+
+	_, err = m.Begin(KindWrite, "POST /parking-out")
+	ls.ErrorIs(err, ErrDraining, "no new work once draining")
+	ls.ErrorIs(m.Go(KindJob, "expire-queue", func(context.Context) {}), ErrDraining)
+}
+
+func (ls *LifecycleSuite) TestAbandonOnDeadline() {
+	m := New()
+	_, err := m.Begin(KindWrite, "PUT /floor")
+	ls.NoError(err)
+
+	stopped := make(chan struct{})
+	ls.NoError(m.Go(KindJob, "expire-queue", func(ctx context.Context) {
+		<-ctx.Done()
+		close(stopped)
+	}))
+
+	flushed := false
+	m.OnFlush("storage", func(ctx context.Context) error {
+		flushed = true
+		return ctx.Err()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	report := m.Shutdown(ctx)
+
+	<-stopped
+	ls.Len(report.Abandoned, 1, "job stopped on cancel, write never finished")
+	ls.Equal("PUT /floor", report.Abandoned[0].Name)
+	ls.True(flushed, "flush run even when deadline passed")
+	ls.Empty(report.Errors, "flush not bound to drain deadline")
+}
+
+func (ls *LifecycleSuite) TestNewTimeout() {
+	ls.Equal(DefaultTimeout, NewTimeout(map[string]map[string]interface{}{}))
+	ls.Equal(5*time.Second, NewTimeout(map[string]map[string]interface{}{"server": {"shutdown_timeout": 5}}))
+}
+
+func TestLifecycleSuite(t *testing.T) {
+	suite.Run(t, new(LifecycleSuite))
+}
@@ -1,16 +1,15 @@
 package logs
 
-import "os"
-
 var (
-	// LogLevel set log level eg: `trace`, `debug`, etc. Default: `error`.
-	LogLevel = os.Getenv("LOG_LEVEL")
+	// LogLevel set log level eg: `trace`, `debug`, etc. Default: `error`. Set from `log.level` config,
+	// use SetLevel once logging started.
+	LogLevel string
 
-	// LogFormat set log format like `text` and `json`. Default: `text`,
-	LogFormat = os.Getenv("LOG_FORMAT")
+	// LogFormat set log format like `text` and `json`. Default: `text`. Set from `log.format` config.
+	LogFormat string
 
-	// ServiceName this service name that run this log.
-	ServiceName = os.Getenv("SERVICE_NAME")
+	// ServiceName this service name that run this log. Set from `system.service_name` config.
+	ServiceName string
 )
 
 const (
@@ -41,6 +40,12 @@ const (
 	// ClientRequestID client request ID for this logger.
 	ClientRequestID = "client_request_id"
 
+	// TraceID trace ID of request, shared with span exported by tracer.
+	TraceID = "trace_id"
+
+	// SpanID span ID of request server span.
+	SpanID = "span_id"
+
 	// ClientDeviceID client request
 	ClientDeviceID = "client_device_id"
 
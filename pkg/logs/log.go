@@ -3,6 +3,7 @@ package logs
 import (
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/mhaikalla/parking-service-management-library/pkg/condutils"
@@ -104,26 +105,53 @@ func (lc *LogrusContext) Child(name string) ILog {
 	return logCtx
 }
 
-// configureLog configure logger log level and output format.
-func configureLog(logger *logrus.Entry, level, format string) {
-	level = strings.ToLower(level)
-	format = strings.ToLower(format)
-	switch level {
-	default:
-		logger.Logger.SetLevel(logrus.ErrorLevel)
+// level log level set by SetLevel, override LogLevel.
+var level atomic.Value
+
+// SetLevel change log level of logger created from now on and of long lived `loggers`, eg: config reloaded
+// while serving. Request logger created per request so follow it from next request.
+func SetLevel(l string, loggers ...ILog) {
+	level.Store(l)
+	for _, logger := range loggers {
+		if lc, ok := logger.(*LogrusContext); ok {
+			lc.lock.Lock()
+			lc.Logger.SetLevel(parseLevel(l))
+			lc.lock.Unlock()
+		}
+	}
+}
+
+// currentLevel level set by SetLevel, LogLevel when never set.
+func currentLevel() string {
+	if l, ok := level.Load().(string); ok {
+		return l
+	}
+	return LogLevel
+}
+
+// parseLevel logrus level of `level`, error level when unknown.
+func parseLevel(level string) logrus.Level {
+	switch strings.ToLower(level) {
 	case "trace":
-		logger.Logger.SetLevel(logrus.TraceLevel)
+		return logrus.TraceLevel
 	case "debug":
-		logger.Logger.SetLevel(logrus.DebugLevel)
+		return logrus.DebugLevel
 	case "info":
-		logger.Logger.SetLevel(logrus.InfoLevel)
+		return logrus.InfoLevel
 	case "warn":
-		logger.Logger.SetLevel(logrus.WarnLevel)
+		return logrus.WarnLevel
 	case "fatal":
-		logger.Logger.SetLevel(logrus.FatalLevel)
+		return logrus.FatalLevel
 	case "panic":
-		logger.Logger.SetLevel(logrus.PanicLevel)
+		return logrus.PanicLevel
 	}
+	return logrus.ErrorLevel
+}
+
+// configureLog configure logger log level and output format.
+func configureLog(logger *logrus.Entry, level, format string) {
+	format = strings.ToLower(format)
+	logger.Logger.SetLevel(parseLevel(level))
 
 	if format == "json" {
 		logger.Logger.SetFormatter(&logrus.JSONFormatter{})
@@ -144,7 +172,8 @@ func NewLogrus(name string) ILog {
 		LogType: name,
 	})
 
-	configureLog(logger, LogLevel, LogFormat)
+	configureLog(logger, currentLevel(), LogFormat)
+	logger.Logger.AddHook(maskHook{})
 
 	return &LogrusContext{
 		ID:             id,
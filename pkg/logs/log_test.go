@@ -1,9 +1,13 @@
 package logs
 
 import (
+	"bytes"
 	"errors"
+	"strings"
 	"testing"
 
+	"github.com/mhaikalla/parking-service-management-library/pkg/secrets"
+
 	"github.com/sirupsen/logrus"
 )
 
@@ -76,3 +80,37 @@ func TestHooks(t *testing.T) {
 	WhenError("no logging", nil, logger)
 	HandlerWhenError(errors.New("test"), logger)
 }
+
+func TestMaskSecret(t *testing.T) {
+	secrets.Register("s3cr3t-value")
+	logger := NewLogrus("TEST")
+	buff := &bytes.Buffer{}
+	logger.(*LogrusContext).Logger.SetOutput(buff)
+
+	logger.Upsert("dsn", "postgres://app:s3cr3t-value@db")
+	logger.Update()
+	logger.Error("connect with s3cr3t-value failed")
+
+	if strings.Contains(buff.String(), "s3cr3t-value") {
+		t.Errorf("secret written on log: %s", buff.String())
+	}
+	if !strings.Contains(buff.String(), "connect with ****** failed") {
+		t.Errorf("secret not masked: %s", buff.String())
+	}
+}
+
+func TestSetLevel(t *testing.T) {
+	defer level.Store(LogLevel)
+	running := NewLogrus("TEST")
+	buff := &bytes.Buffer{}
+	running.(*LogrusContext).Logger.SetOutput(buff)
+
+	SetLevel("info", running)
+	running.Info("running logger follow reloaded level")
+	if !strings.Contains(buff.String(), "running logger follow reloaded level") {
+		t.Errorf("info not logged after level reloaded: %s", buff.String())
+	}
+	if lvl := NewLogrus("TEST").(*LogrusContext).Logger.GetLevel(); lvl != logrus.InfoLevel {
+		t.Errorf("new logger level %v, want info", lvl)
+	}
+}
@@ -0,0 +1,29 @@
+package logs
+
+import (
+	"github.com/mhaikalla/parking-service-management-library/pkg/secrets"
+
+	"github.com/sirupsen/logrus"
+)
+
+// maskHook mask secret registered on `secrets` from message and fields before entry written.
+type maskHook struct{}
+
+func (maskHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (maskHook) Fire(entry *logrus.Entry) error {
+	entry.Message = secrets.Mask(entry.Message)
+	for k, v := range entry.Data {
+		switch value := v.(type) {
+		case string:
+			entry.Data[k] = secrets.Mask(value)
+		case error:
+			if masked := secrets.Mask(value.Error()); masked != value.Error() {
+				entry.Data[k] = masked
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MetricsSuite struct {
+	suite.Suite
+}
+
+func (ms *MetricsSuite) TestWriteText() {
+	r := NewRegistry()
+	requests := r.NewCounter("http_requests_total", "Requests served.", "method", "status")
+	latency := r.NewHistogram("http_request_duration_seconds", "Latency.", []float64{0.5, 0.1}, "method")
+	size := r.NewGauge("file_size_bytes", "Size of file.", "table")
+
+	requests.Inc("GET", "200")
+	requests.Add(2, "GET", "200")
+	requests.Inc("POST", "500")
+	latency.Observe(0.05, "GET")
+	latency.Observe(0.3, "GET")
+	size.Set(10, `a"b`)
+
+	out := &strings.Builder{}
+	ms.NoError(r.WriteText(out))
+	ms.Equal(`# HELP http_requests_total Requests served.
+# TYPE http_requests_total counter
+http_requests_total{method="GET",status="200"} 3
+http_requests_total{method="POST",status="500"} 1
+# HELP http_request_duration_seconds Latency.
+# TYPE http_request_duration_seconds histogram
+http_request_duration_seconds_bucket{method="GET",le="0.1"} 1
+http_request_duration_seconds_bucket{method="GET",le="0.5"} 2
+http_request_duration_seconds_bucket{method="GET",le="+Inf"} 2
+http_request_duration_seconds_sum{method="GET"} 0.35
+http_request_duration_seconds_count{method="GET"} 2
+# HELP file_size_bytes Size of file.
+# TYPE file_size_bytes gauge
+file_size_bytes{table="a\"b"} 10
+`, out.String())
+}
+
+func (ms *MetricsSuite) TestBeforeWriteAndReset() {
+	r := NewRegistry()
+	occupied := r.NewGauge("occupied", "Occupied.", "floor")
+	occupied.Set(3, "old")
+	r.BeforeWrite(func() {
+		occupied.Reset()
+		occupied.Set(1, "new")
+	})
+
+	out := &strings.Builder{}
+	ms.NoError(r.WriteText(out))
+	ms.Contains(out.String(), `occupied{floor="new"} 1`)
+	ms.NotContains(out.String(), "old", "reset gauge must drop removed series")
+}
+
+func (ms *MetricsSuite) TestInvalidUsage() {
+	r := NewRegistry()
+	c := r.NewCounter("total", "Total.", "kind")
+	ms.Panics(func() { r.NewGauge("total", "Again.") }, "name must be unique")
+	ms.Panics(func() { c.Inc() }, "label values must match labels")
+
+	c.Add(-1, "a")
+	ms.Equal(0.0, c.Value("a"), "counter must not go down")
+}
+
+func TestMetricsSuite(t *testing.T) {
+	suite.Run(t, new(MetricsSuite))
+}
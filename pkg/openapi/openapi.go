@@ -0,0 +1,277 @@
+// Package openapi build OpenAPI 3 document of registered routes from their request/response models,
+// `validate` tags of the models turned into schema constraints.
+package openapi
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Version of OpenAPI specification document follow.
+const Version = "3.0.3"
+
+// security scheme names of document components.
+const (
+	SecurityBearer = "bearerAuth"
+	SecurityAPIKey = "apiKeyAuth"
+)
+
+var rePathParam = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+// Document OpenAPI 3 document root.
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+// Info metadata of the API.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// PathItem operations of one path keyed by lower case http method.
+type PathItem map[string]*OperationObject
+
+// OperationObject one documented operation.
+type OperationObject struct {
+	Summary     string                `json:"summary,omitempty"`
+	Description string                `json:"description,omitempty"`
+	OperationId string                `json:"operationId,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]Response   `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+// Parameter path, query or header parameter.
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody json body of operation.
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response one response of operation.
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType schema of content.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components schemas and security schemes referenced by operations.
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes"`
+}
+
+// SecurityScheme how caller authenticated.
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	In           string `json:"in,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+// Route registered route to be documented.
+type Route struct {
+	Method string
+	// Path on echo notation, `:name` segment documented as path parameter.
+	Path string
+	// Auth whether route require bearer token.
+	Auth bool
+	// Roles allowed to call authenticated route, any role when empty.
+	Roles []string
+	// Scopes of API key accepted by route, API key not accepted when empty.
+	Scopes []string
+}
+
+// Operation models of one route, nil model means route has no such part.
+type Operation struct {
+	Summary string
+	Tags    []string
+	// Query struct whose json fields read from query string.
+	Query interface{}
+	// Params parameters not described by `Query`, also override path parameter of same name.
+	Params []Parameter
+	// Request model of json body.
+	Request interface{}
+	// Response model of success response.
+	Response interface{}
+	// Status http status of success response, default 200.
+	Status int
+	// Envelope model wrapping every response of route, its `data` property documented as `Response` on success
+	// and envelope itself documented as error response instead of `Generator.Error`.
+	Envelope interface{}
+}
+
+// Generator build document from routes.
+type Generator struct {
+	Info Info
+	// Error model of error response, documented as default response of every operation.
+	Error interface{}
+}
+
+// Generate document of `routes`, `find` return operation of each route. Routes without operation are left
+// out of document and returned so caller can report them.
+func (g Generator) Generate(routes []Route, find func(Route) (Operation, bool)) (*Document, []Route) {
+	reg := newRegistry()
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    g.Info,
+		Paths:   map[string]PathItem{},
+		Components: Components{
+			Schemas: reg.schemas,
+			SecuritySchemes: map[string]SecurityScheme{
+				SecurityBearer: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+				SecurityAPIKey: {Type: "apiKey", In: "header", Name: "x-api-key"},
+			},
+		},
+	}
+
+	var errSchema *Schema
+	if g.Error != nil {
+		errSchema = reg.schemaOf(g.Error)
+	}
+
+	missing := []Route{}
+	for _, r := range routes {
+		op, found := find(r)
+		if !found {
+			missing = append(missing, r)
+			continue
+		}
+		path, params := pathTemplate(r.Path)
+		item, ok := doc.Paths[path]
+		if !ok {
+			item = PathItem{}
+			doc.Paths[path] = item
+		}
+		item[strings.ToLower(r.Method)] = g.operation(reg, r, op, params, errSchema)
+	}
+	return doc, missing
+}
+
+// operation build operation object of route `r`.
+func (g Generator) operation(reg *registry, r Route, op Operation, pathParams []string, errSchema *Schema) *OperationObject {
+	o := &OperationObject{
+		Summary:     op.Summary,
+		OperationId: operationId(r),
+		Tags:        op.Tags,
+		Responses:   map[string]Response{},
+	}
+
+	overrides := map[string]Parameter{}
+	for _, p := range op.Params {
+		overrides[p.In+":"+p.Name] = p
+	}
+	for _, name := range pathParams {
+		p, ok := overrides["path:"+name]
+		if !ok {
+			p = Parameter{Name: name, In: "path", Schema: &Schema{Type: "string"}}
+		}
+		p.Required = true
+		o.Parameters = append(o.Parameters, p)
+		delete(overrides, "path:"+name)
+	}
+	if op.Query != nil {
+		o.Parameters = append(o.Parameters, queryParams(op.Query)...)
+	}
+	for _, p := range op.Params {
+		if _, ok := overrides[p.In+":"+p.Name]; ok {
+			o.Parameters = append(o.Parameters, p)
+		}
+	}
+
+	if op.Request != nil {
+		o.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{"application/json": {Schema: reg.schemaOf(op.Request)}},
+		}
+	}
+
+	status := op.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	success := Response{Description: http.StatusText(status)}
+	if op.Envelope != nil {
+		var data *Schema
+		if op.Response != nil {
+			data = reg.schemaOf(op.Response)
+		}
+		errSchema = reg.schemaOf(op.Envelope)
+		success.Content = map[string]MediaType{"application/json": {Schema: reg.wrap(errSchema, data)}}
+	} else if op.Response != nil {
+		success.Content = map[string]MediaType{"application/json": {Schema: reg.schemaOf(op.Response)}}
+	}
+	o.Responses[strconv.Itoa(status)] = success
+	if errSchema != nil {
+		o.Responses["default"] = Response{
+			Description: "Error",
+			Content:     map[string]MediaType{"application/json": {Schema: errSchema}},
+		}
+	}
+
+	if r.Auth {
+		o.Security = []map[string][]string{{SecurityBearer: {}}}
+		if len(r.Scopes) > 0 {
+			o.Security = append(o.Security, map[string][]string{SecurityAPIKey: {}})
+		}
+		o.Description = permissionDescription(r)
+	}
+	return o
+}
+
+// pathTemplate convert echo path to OpenAPI template, returning name of its parameters.
+func pathTemplate(path string) (string, []string) {
+	params := []string{}
+	for _, m := range rePathParam.FindAllStringSubmatch(path, -1) {
+		params = append(params, m[1])
+	}
+	return rePathParam.ReplaceAllString(path, "{$1}"), params
+}
+
+// operationId unique id of route made of its method and path.
+func operationId(r Route) string {
+	id := strings.ToLower(r.Method)
+	for _, part := range strings.FieldsFunc(r.Path, func(c rune) bool {
+		return c == '/' || c == '-' || c == '_' || c == ':'
+	}) {
+		id += strings.ToUpper(part[:1]) + part[1:]
+	}
+	return id
+}
+
+// permissionDescription describe who may call authenticated route.
+func permissionDescription(r Route) string {
+	desc := "Roles: any."
+	if len(r.Roles) > 0 {
+		roles := append([]string{}, r.Roles...)
+		sort.Strings(roles)
+		desc = "Roles: " + strings.Join(roles, ", ") + "."
+	}
+	if len(r.Scopes) > 0 {
+		desc += " API key scopes: " + strings.Join(r.Scopes, ", ") + "."
+	}
+	return desc
+}
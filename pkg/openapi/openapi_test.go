@@ -0,0 +1,157 @@
+package openapi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type listParams struct {
+	Search string `json:"search"`
+	Limit  int    `json:"limit" validate:"gte=0,lte=100"`
+}
+
+type lotRequest struct {
+	listParams
+	Name     string     `json:"name" validate:"required,min=3"`
+	Status   string     `json:"status" validate:"required,oneof=AVAILABLE DISABLED"`
+	Floors   []int      `json:"floors" validate:"required,min=1,dive,gt=0"`
+	Version  *int       `json:"version"`
+	StartAt  time.Time  `json:"start_at"`
+	EndAt    *time.Time `json:"end_at"`
+	internal string
+	Skipped  string `json:"-"`
+}
+
+type lotResponse struct {
+	Id   int        `json:"id"`
+	Lot  lotRequest `json:"lot"`
+	Lots []lotRequest
+}
+
+type apiError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type envelope struct {
+	Data  interface{} `json:"data"`
+	Error *apiError   `json:"error"`
+}
+
+type OpenAPITestSuite struct {
+	suite.Suite
+}
+
+func (s *OpenAPITestSuite) TestSchemaFromValidateTags() {
+	schema := SchemaOf(lotRequest{})
+
+	s.Equal("object", schema.Type)
+	s.Equal([]string{"name", "status", "floors"}, schema.Required, "required fields must be listed")
+	s.Contains(schema.Properties, "search", "embedded struct must be flattened")
+	s.NotContains(schema.Properties, "internal", "unexported field must be skipped")
+	s.NotContains(schema.Properties, "Skipped", "field excluded from json must be skipped")
+
+	s.Equal(0.0, *schema.Properties["limit"].Minimum)
+	s.Equal(100.0, *schema.Properties["limit"].Maximum)
+	s.Equal(3, *schema.Properties["name"].MinLength)
+	s.Equal([]interface{}{"AVAILABLE", "DISABLED"}, schema.Properties["status"].Enum)
+
+	floors := schema.Properties["floors"]
+	s.Equal("array", floors.Type)
+	s.Equal(1, *floors.MinItems)
+	s.Equal(0.0, *floors.Items.Minimum, "rules after dive must constrain items")
+	s.True(floors.Items.ExclusiveMinimum)
+
+	s.True(schema.Properties["version"].Nullable, "pointer must be nullable")
+	s.Equal("date-time", schema.Properties["start_at"].Format)
+	s.True(schema.Properties["end_at"].Nullable)
+}
+
+func (s *OpenAPITestSuite) TestGenerate() {
+	g := Generator{Info: Info{Title: "test", Version: "1"}, Error: apiError{}}
+	routes := []Route{
+		{Method: "GET", Path: "/lot/:id"},
+		{Method: "POST", Path: "/lot", Auth: true, Roles: []string{"operator", "admin"}, Scopes: []string{"write"}},
+		{Method: "GET", Path: "/lots"},
+		{Method: "DELETE", Path: "/lot"},
+	}
+	ops := map[string]Operation{
+		"GET /lot/:id": {Summary: "Detail", Response: lotResponse{}},
+		"POST /lot":    {Request: lotRequest{}, Response: lotResponse{}, Status: 201},
+		"GET /lots":    {Query: listParams{}, Params: []Parameter{{Name: "active", In: "query", Schema: &Schema{Type: "boolean"}}}},
+	}
+
+	doc, missing := g.Generate(routes, func(r Route) (Operation, bool) {
+		op, ok := ops[r.Method+" "+r.Path]
+		return op, ok
+	})
+
+	s.Equal([]Route{routes[3]}, missing, "route without operation must be reported")
+	s.Equal(Version, doc.OpenAPI)
+
+	detail := doc.Paths["/lot/{id}"]["get"]
+	s.Require().NotNil(detail, "echo path parameter must be templated")
+	s.Equal([]Parameter{{Name: "id", In: "path", Required: true, Schema: &Schema{Type: "string"}}}, detail.Parameters)
+	s.Equal("#/components/schemas/lotResponse", detail.Responses["200"].Content["application/json"].Schema.Ref)
+	s.Equal("#/components/schemas/apiError", detail.Responses["default"].Content["application/json"].Schema.Ref)
+	s.Empty(detail.Security, "public route has no security")
+
+	create := doc.Paths["/lot"]["post"]
+	s.Contains(create.Responses, "201")
+	s.Equal("#/components/schemas/lotRequest", create.RequestBody.Content["application/json"].Schema.Ref)
+	s.Equal([]map[string][]string{{SecurityBearer: {}}, {SecurityAPIKey: {}}}, create.Security)
+	s.Equal("Roles: admin, operator. API key scopes: write.", create.Description)
+
+	list := doc.Paths["/lots"]["get"]
+	s.Len(list.Parameters, 3)
+	s.Equal("search", list.Parameters[0].Name)
+	s.Equal("active", list.Parameters[2].Name)
+
+	s.Contains(doc.Components.Schemas, "lotRequest", "referenced model must be on components")
+	s.Equal("#/components/schemas/lotRequest", doc.Components.Schemas["lotResponse"].Properties["lot"].Ref)
+
+	_, err := json.Marshal(doc)
+	s.NoError(err)
+}
+
+func (s *OpenAPITestSuite) TestGenerateEnvelope() {
+	g := Generator{Info: Info{Title: "test", Version: "2"}, Error: apiError{}}
+	routes := []Route{{Method: "GET", Path: "/lots"}, {Method: "DELETE", Path: "/lot"}}
+	ops := map[string]Operation{
+		"GET /lots":   {Response: []lotResponse{}, Envelope: envelope{}},
+		"DELETE /lot": {Envelope: envelope{}},
+	}
+
+	doc, missing := g.Generate(routes, func(r Route) (Operation, bool) {
+		op, ok := ops[r.Method+" "+r.Path]
+		return op, ok
+	})
+	s.Empty(missing)
+
+	list := doc.Paths["/lots"]["get"]
+	success := list.Responses["200"].Content["application/json"].Schema
+	s.Equal("array", success.Properties["data"].Type, "data must be documented as response model")
+	s.Equal("#/components/schemas/lotResponse", success.Properties["data"].Items.Ref)
+	s.Contains(success.Properties, "error", "other envelope properties must be kept")
+	s.Equal("#/components/schemas/envelope", list.Responses["default"].Content["application/json"].Schema.Ref,
+		"envelope must be documented as error response")
+	s.Equal(&Schema{}, doc.Components.Schemas["envelope"].Properties["data"], "shared envelope must be left untouched")
+
+	remove := doc.Paths["/lot"]["delete"]
+	s.True(remove.Responses["200"].Content["application/json"].Schema.Properties["data"].Nullable)
+}
+
+func (s *OpenAPITestSuite) TestSwaggerUI() {
+	page := string(SwaggerUI("Parking <API>", "/openapi.json"))
+	s.Contains(page, "swagger-ui")
+	s.Contains(page, "Parking &lt;API&gt;", "title must be escaped")
+	s.True(strings.Contains(page, `"\/openapi.json"`) || strings.Contains(page, `"/openapi.json"`), "page must load document url")
+}
+
+func TestOpenAPISuite(t *testing.T) {
+	suite.Run(t, new(OpenAPITestSuite))
+}
@@ -0,0 +1,350 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	typeTime       = reflect.TypeOf(time.Time{})
+	typeRawMessage = reflect.TypeOf(json.RawMessage{})
+)
+
+// Schema JSON schema of OpenAPI 3.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	ExclusiveMinimum     bool               `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     bool               `json:"exclusiveMaximum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+}
+
+// registry keep named struct schemas referenced from operations.
+type registry struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+}
+
+func newRegistry() *registry {
+	return &registry{schemas: map[string]*Schema{}, names: map[reflect.Type]string{}}
+}
+
+// SchemaOf schema of model `v` with named structs inlined, used where no document is built.
+func SchemaOf(v interface{}) *Schema {
+	reg := newRegistry()
+	reg.names = nil
+	return reg.schemaOf(v)
+}
+
+func (reg *registry) schemaOf(v interface{}) *Schema {
+	return reg.schemaOfType(reflect.TypeOf(v))
+}
+
+func (reg *registry) schemaOfType(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == typeTime:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t == typeRawMessage:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		zero := 0.0
+		return &Schema{Type: "integer", Minimum: &zero}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: reg.schemaOfType(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: reg.schemaOfType(t.Elem())}
+	case reflect.Struct:
+		return reg.structRef(t)
+	}
+	// interface or unsupported kind accept any value
+	return &Schema{}
+}
+
+// structRef reference to component schema of named struct `t`, anonymous struct inlined.
+func (reg *registry) structRef(t reflect.Type) *Schema {
+	if t.Name() == "" || reg.names == nil {
+		return reg.structSchema(t)
+	}
+	if name, ok := reg.names[t]; ok {
+		return &Schema{Ref: "#/components/schemas/" + name}
+	}
+
+	name := t.Name()
+	if _, taken := reg.schemas[name]; taken {
+		pkg := t.PkgPath()
+		name = pkg[strings.LastIndex(pkg, "/")+1:] + "." + name
+	}
+	// registered before fields so recursive type reference itself
+	reg.names[t] = name
+	reg.schemas[name] = &Schema{}
+	*reg.schemas[name] = *reg.structSchema(t)
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// wrap inline copy of `envelope` schema whose `data` property replaced by `data`, nil `data` documented as null.
+func (reg *registry) wrap(envelope, data *Schema) *Schema {
+	if envelope.Ref != "" {
+		envelope = reg.schemas[strings.TrimPrefix(envelope.Ref, "#/components/schemas/")]
+	}
+	wrapped := *envelope
+	wrapped.Properties = map[string]*Schema{}
+	for name, prop := range envelope.Properties {
+		wrapped.Properties[name] = prop
+	}
+	if data == nil {
+		data = &Schema{Nullable: true}
+	}
+	wrapped.Properties["data"] = data
+	return &wrapped
+}
+
+func (reg *registry) structSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	reg.addFields(s, t)
+	return s
+}
+
+// addFields add json fields of struct `t` to `s`, embedded struct without json name flattened.
+func (reg *registry) addFields(s *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, ok := jsonName(f)
+		if !ok {
+			continue
+		}
+		if name == "" {
+			ft := f.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				reg.addFields(s, ft)
+			}
+			continue
+		}
+
+		field := reg.schemaOfType(f.Type)
+		if f.Type.Kind() == reflect.Ptr && field.Ref == "" {
+			field.Nullable = true
+		}
+		if applyValidate(field, f.Type, f.Tag.Get("validate")) {
+			s.Required = append(s.Required, name)
+		}
+		s.Properties[name] = field
+	}
+}
+
+// queryParams parameters of query string read into json fields of struct `v`.
+func queryParams(v interface{}) []Parameter {
+	s := SchemaOf(v)
+	required := map[string]bool{}
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	names := fieldNames(t, nil)
+	params := make([]Parameter, 0, len(names))
+	for _, name := range names {
+		params = append(params, Parameter{Name: name, In: "query", Required: required[name], Schema: s.Properties[name]})
+	}
+	return params
+}
+
+// fieldNames json names of struct `t` on declaration order, embedded struct flattened.
+func fieldNames(t reflect.Type, names []string) []string {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, ok := jsonName(f)
+		switch {
+		case !ok:
+		case name == "" && f.Type.Kind() == reflect.Struct:
+			names = fieldNames(f.Type, names)
+		case name != "":
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// jsonName name of field on json, empty for embedded struct without json name, false when not serialized.
+func jsonName(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	name := strings.Split(tag, ",")[0]
+	if f.Anonymous && name == "" {
+		return "", true
+	}
+	if f.PkgPath != "" {
+		return "", false
+	}
+	if name == "" {
+		name = f.Name
+	}
+	return name, true
+}
+
+// applyValidate turn `validate` tag rules into constraints of `s`, returning whether field required.
+// Rules after `dive` constrain items of slice.
+func applyValidate(s *Schema, t reflect.Type, tag string) bool {
+	if tag == "" {
+		return false
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	required := false
+	rules := strings.Split(tag, ",")
+	for i, rule := range rules {
+		name, param := rule, ""
+		if idx := strings.Index(rule, "="); idx >= 0 {
+			name, param = rule[:idx], rule[idx+1:]
+		}
+
+		switch name {
+		case "required":
+			required = true
+		case "dive":
+			if s.Items != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+				applyValidate(s.Items, t.Elem(), strings.Join(rules[i+1:], ","))
+			}
+			return required
+		case "len":
+			setMin(s, t, param, false)
+			setMax(s, t, param, false)
+		case "min", "gte":
+			setMin(s, t, param, false)
+		case "gt":
+			setMin(s, t, param, true)
+		case "max", "lte":
+			setMax(s, t, param, false)
+		case "lt":
+			setMax(s, t, param, true)
+		case "oneof":
+			for _, v := range strings.Fields(param) {
+				s.Enum = append(s.Enum, enumValue(s.Type, v))
+			}
+		case "numeric":
+			s.Pattern = `^[-+]?[0-9]+(\.[0-9]+)?$`
+		case "alphanum":
+			s.Pattern = `^[A-Za-z0-9]+$`
+		case "email":
+			s.Format = "email"
+		case "url", "uri":
+			s.Format = "uri"
+		case "uuid", "uuid4":
+			s.Format = "uuid"
+		case "ipv4", "ipv6":
+			s.Format = name
+		}
+	}
+	return required
+}
+
+// setMin lower bound of number, or minimum length of string / minimum items of collection.
+func setMin(s *Schema, t reflect.Type, param string, exclusive bool) {
+	switch t.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return
+		}
+		if exclusive {
+			n++
+		}
+		if t.Kind() == reflect.String {
+			s.MinLength = &n
+		} else {
+			s.MinItems = &n
+		}
+	default:
+		if f, err := strconv.ParseFloat(param, 64); err == nil {
+			s.Minimum = &f
+			s.ExclusiveMinimum = exclusive
+		}
+	}
+}
+
+// setMax upper bound of number, or maximum length of string / maximum items of collection.
+func setMax(s *Schema, t reflect.Type, param string, exclusive bool) {
+	switch t.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return
+		}
+		if exclusive {
+			n--
+		}
+		if t.Kind() == reflect.String {
+			s.MaxLength = &n
+		} else {
+			s.MaxItems = &n
+		}
+	default:
+		if f, err := strconv.ParseFloat(param, 64); err == nil {
+			s.Maximum = &f
+			s.ExclusiveMaximum = exclusive
+		}
+	}
+}
+
+// enumValue `oneof` value typed as schema type.
+func enumValue(schemaType, v string) interface{} {
+	switch schemaType {
+	case "integer":
+		if n, err := strconv.Atoi(v); err == nil {
+			return n
+		}
+	case "number":
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+	}
+	return v
+}
@@ -0,0 +1,22 @@
+package openapi
+
+import (
+	"bytes"
+	_ "embed"
+	"html/template"
+)
+
+//go:embed swagger.html
+var swaggerPage string
+
+var swaggerTemplate = template.Must(template.New("swagger").Parse(swaggerPage))
+
+// SwaggerUI html page rendering document served on `specURL` with Swagger UI.
+func SwaggerUI(title, specURL string) []byte {
+	var buff bytes.Buffer
+	_ = swaggerTemplate.Execute(&buff, struct {
+		Title   string
+		SpecURL string
+	}{title, specURL})
+	return buff.Bytes()
+}
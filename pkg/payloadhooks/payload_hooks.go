@@ -4,9 +4,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"os"
 	"reflect"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/mhaikalla/parking-service-management-library/pkg/condutils"
@@ -21,18 +21,34 @@ var (
 	// PayloadCryptoStrict will decide level of strictness of payload encryption mode.
 	// `strict` will only accept/respond with ciphered data and time. Return error if ciphered data not found or un-decrypt-able.
 	// `nonstrict` will accept/respond chipered data, time, and plain data. Return error when ciphered data exist and un-decrypt-able.
-	PayloadCryptoStrict = condutils.Or(os.Getenv("PAYLOAD_CRYPTO_STRICT"), "").(string)
-
-	// PayloadCryptoFeature set env var to `1` to enable payload crypto hooks on request/response.
-	PayloadCryptoFeature = os.Getenv("PAYLOAD_CRYPTO")
+	PayloadCryptoStrict string
 
 	// PayloadCryptoKey to set key used in payload crypto.
-	PayloadCryptoKey = condutils.Or(os.Getenv("PAYLOAD_CRYPTO_KEY"), "880d8e7e9b4b787aa50a3917b09fc0ec").(string)
+	PayloadCryptoKey = "880d8e7e9b4b787aa50a3917b09fc0ec"
 
-	// PayloadCryptoMinAppVer set env var to `current app version code` to enable payload crypto hooks on configured version.
-	PayloadCryptoMinAppVer = os.Getenv("PAYLOAD_CRYPTO_MIN_APP_VERSION")
+	// PayloadCryptoMinAppVer `current app version code` to enable payload crypto hooks on configured version,
+	// set from `payload_crypto.min_app_version` config.
+	PayloadCryptoMinAppVer string
 )
 
+// payloadCryptoFeature `1` when payload crypto hooks enabled on request/response, set from `payload_crypto.enable` config.
+// Read on every request while config reload may switch it, so accessed atomically.
+var payloadCryptoFeature int32
+
+// SetPayloadCryptoFeature enable or disable payload crypto hooks, applied from next request.
+func SetPayloadCryptoFeature(enable bool) {
+	var v int32
+	if enable {
+		v = 1
+	}
+	atomic.StoreInt32(&payloadCryptoFeature, v)
+}
+
+// PayloadCryptoEnabled whether payload crypto hooks enabled.
+func PayloadCryptoEnabled() bool {
+	return atomic.LoadInt32(&payloadCryptoFeature) == 1
+}
+
 // EncryptedResponse encrypted response for strict mode of encryption payload mode.
 type EncryptedResponse struct {
 	Ciphered  string `json:"xdata"`
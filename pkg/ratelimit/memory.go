@@ -0,0 +1,61 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepInterval interval memory store forget full buckets.
+const sweepInterval = time.Minute
+
+type memoryEntry struct {
+	bucket Bucket
+	limit  Limit
+}
+
+// MemoryStore keep buckets in process memory, state not shared between instances.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryEntry
+	lastSweep time.Time
+}
+
+// NewMemoryStore create empty memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: map[string]*memoryEntry{}}
+}
+
+// Take take a token from bucket of `key`.
+func (s *MemoryStore) Take(key string, limit Limit, now time.Time) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+	entry, ok := s.buckets[key]
+	if !ok {
+		entry = &memoryEntry{bucket: NewBucket(limit, now)}
+		s.buckets[key] = entry
+	}
+	entry.limit = limit
+	return entry.bucket.Take(limit, now), nil
+}
+
+// Len number of buckets kept.
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.buckets)
+}
+
+// sweep forget bucket already refilled, so idle clients not kept forever.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, entry := range s.buckets {
+		if entry.bucket.IsFull(entry.limit, now) {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Package ratelimit token bucket rate limiter with pluggable bucket store.
+package ratelimit
+
+import (
+	"math"
+	"time"
+)
+
+// Limit token bucket refilled with `Requests` tokens every `Period`, holding at most `Burst` tokens.
+// Burst default to `Requests` when not set.
+type Limit struct {
+	Requests int
+	Period   time.Duration
+	Burst    int
+}
+
+// capacity maximum tokens bucket can hold.
+func (l Limit) capacity() float64 {
+	if l.Burst > 0 {
+		return float64(l.Burst)
+	}
+	return float64(l.Requests)
+}
+
+// rate tokens refilled every second.
+func (l Limit) rate() float64 {
+	return float64(l.Requests) / l.Period.Seconds()
+}
+
+// Result of taking a token from bucket.
+type Result struct {
+	Allowed bool
+	// Limit bucket capacity.
+	Limit int
+	// Remaining tokens left after this request.
+	Remaining int
+	// RetryAfter time until next token available, zero when request allowed.
+	RetryAfter time.Duration
+	// ResetAfter time until bucket full again.
+	ResetAfter time.Duration
+}
+
+// Bucket state of a token bucket, exported so store other than memory can persist it.
+type Bucket struct {
+	Tokens    float64   `json:"tokens"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewBucket create full bucket for `limit`.
+func NewBucket(limit Limit, now time.Time) Bucket {
+	return Bucket{Tokens: limit.capacity(), UpdatedAt: now}
+}
+
+// Take refill bucket up to `now` then take a token when available.
+func (b *Bucket) Take(limit Limit, now time.Time) Result {
+	capacity := limit.capacity()
+	rate := limit.rate()
+
+	if elapsed := now.Sub(b.UpdatedAt).Seconds(); elapsed > 0 {
+		b.Tokens = math.Min(capacity, b.Tokens+elapsed*rate)
+		b.UpdatedAt = now
+	}
+
+	res := Result{Limit: int(capacity)}
+	if b.Tokens >= 1 {
+		b.Tokens--
+		res.Allowed = true
+	} else {
+		res.RetryAfter = seconds((1 - b.Tokens) / rate)
+	}
+	res.Remaining = int(math.Floor(b.Tokens))
+	res.ResetAfter = seconds((capacity - b.Tokens) / rate)
+	return res
+}
+
+// IsFull check whether bucket refilled to its capacity at `now`, full bucket is safe to forget.
+func (b *Bucket) IsFull(limit Limit, now time.Time) bool {
+	return b.Tokens+now.Sub(b.UpdatedAt).Seconds()*limit.rate() >= limit.capacity()
+}
+
+// seconds convert fractional seconds to duration.
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// Store keep bucket of every key, implementation must be safe for concurrent use.
+type Store interface {
+	Take(key string, limit Limit, now time.Time) (Result, error)
+}
@@ -0,0 +1,130 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitTestSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func (s *RateLimitTestSuite) SetupTest() {
+	s.now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *RateLimitTestSuite) TestBucket() {
+	limit := Limit{Requests: 2, Period: time.Second}
+	b := NewBucket(limit, s.now)
+
+	s.True(b.Take(limit, s.now).Allowed, "first request must be allowed")
+	res := b.Take(limit, s.now)
+	s.True(res.Allowed, "second request must be allowed")
+	s.Equal(0, res.Remaining, "no token left")
+	s.Equal(2, res.Limit, "limit is bucket capacity")
+
+	res = b.Take(limit, s.now)
+	s.False(res.Allowed, "third request must be limited")
+	s.Equal(500*time.Millisecond, res.RetryAfter, "one token refilled every half second")
+	s.Equal(time.Second, res.ResetAfter, "bucket full after a second")
+
+	s.True(b.Take(limit, s.now.Add(500*time.Millisecond)).Allowed, "refilled token must be allowed")
+	s.True(b.IsFull(limit, s.now.Add(2*time.Second)), "bucket full after idle")
+}
+
+func (s *RateLimitTestSuite) TestBurst() {
+	limit := Limit{Requests: 60, Period: time.Minute, Burst: 3}
+	b := NewBucket(limit, s.now)
+	for i := 0; i < 3; i++ {
+		s.True(b.Take(limit, s.now).Allowed, "request within burst must be allowed")
+	}
+	s.False(b.Take(limit, s.now).Allowed, "request over burst must be limited")
+	s.True(b.Take(limit, s.now.Add(time.Second)).Allowed, "token refilled every second")
+}
+
+func (s *RateLimitTestSuite) TestMemoryStoreSweep() {
+	store := NewMemoryStore()
+	limit := Limit{Requests: 1, Period: time.Second}
+
+	res, err := store.Take("a", limit, s.now)
+	s.NoError(err)
+	s.True(res.Allowed, "first request allowed")
+	res, _ = store.Take("a", limit, s.now)
+	s.False(res.Allowed, "key a limited")
+	res, _ = store.Take("b", limit, s.now)
+	s.True(res.Allowed, "key b has its own bucket")
+
+	_, _ = store.Take("c", limit, s.now.Add(2*time.Minute))
+	s.Equal(1, store.Len(), "refilled buckets forgotten")
+}
+
+func (s *RateLimitTestSuite) TestLimiter() {
+	rules, err := ParseConfig(map[string]interface{}{
+		"default": map[string]interface{}{"requests": 100, "period": 60},
+		"routes": []interface{}{
+			map[string]interface{}{"method": "POST", "path": "/auth/token", "requests": 1, "period": 60, "by": "ip"},
+			map[string]interface{}{"path": "/reports", "requests": 1, "period": 60, "by": "route"},
+		},
+	})
+	s.NoError(err, "config must be parsed")
+	s.Len(rules, 3, "route rules then default rule")
+
+	l := NewLimiter(nil, rules...)
+	idx, rule, ok := l.Match("POST", "/auth/token")
+	s.True(ok)
+	s.Equal(ByIP, rule.By, "route rule matched first")
+
+	res, _ := l.Take(idx, "10.0.0.1", s.now)
+	s.True(res.Allowed)
+	res, _ = l.Take(idx, "10.0.0.1", s.now)
+	s.False(res.Allowed, "same ip limited")
+	res, _ = l.Take(idx, "10.0.0.2", s.now)
+	s.True(res.Allowed, "other ip has its own bucket")
+
+	idx, _, _ = l.Match("GET", "/reports")
+	res, _ = l.Take(idx, "a", s.now)
+	s.True(res.Allowed)
+	res, _ = l.Take(idx, "b", s.now)
+	s.False(res.Allowed, "route bucket shared by all clients")
+
+	_, rule, ok = l.Match("GET", "/other")
+	s.True(ok)
+	s.Equal(BySubject, rule.By, "default rule count by subject")
+}
+
+func (s *RateLimitTestSuite) TestSetRules() {
+	l := NewLimiter(nil, Rule{Path: "/reports", By: ByRoute, Limit: Limit{Requests: 1, Period: time.Minute}})
+	idx, _, _ := l.Match("GET", "/reports")
+	res, _ := l.Take(idx, "a", s.now)
+	s.True(res.Allowed)
+
+	l.SetRules()
+	res, _ = l.Take(idx, "a", s.now)
+	s.True(res.Allowed, "rule matched before replaced no longer limit")
+	_, _, ok := l.Match("GET", "/reports")
+	s.False(ok, "no rule left")
+
+	l.SetRules(Rule{Path: "/reports", By: ByRoute, Limit: Limit{Requests: 1, Period: time.Minute}})
+	idx, _, _ = l.Match("GET", "/reports")
+	res, _ = l.Take(idx, "a", s.now)
+	s.False(res.Allowed, "bucket of same rule kept across replacing")
+}
+
+func (s *RateLimitTestSuite) TestParseConfigError() {
+	_, err := ParseConfig(map[string]interface{}{
+		"default": map[string]interface{}{"requests": 0, "period": 60},
+	})
+	s.Error(err, "zero requests must be rejected")
+
+	_, err = ParseConfig(map[string]interface{}{
+		"routes": []interface{}{map[string]interface{}{"requests": 1, "period": 1, "by": "user"}},
+	})
+	s.Error(err, "unknown key must be rejected")
+}
+
+func TestRateLimitSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
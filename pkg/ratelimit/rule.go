@@ -0,0 +1,150 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Key a rule count requests by.
+const (
+	// ByIP every client IP has its own bucket.
+	ByIP = "ip"
+	// BySubject every JWT subject or API key has its own bucket, anonymous request counted by IP.
+	BySubject = "subject"
+	// ByRoute all clients share one bucket of the route.
+	ByRoute = "route"
+)
+
+// Rule limit applied to request matching `Method` and `Path` route pattern, empty or `*` match any.
+type Rule struct {
+	Method string
+	Path   string
+	By     string
+	Limit
+}
+
+// matches check whether rule apply to `method` and route `path`.
+func (r Rule) matches(method, path string) bool {
+	return (r.Method == "" || r.Method == "*" || strings.EqualFold(r.Method, method)) &&
+		(r.Path == "" || r.Path == "*" || r.Path == path)
+}
+
+// Limiter apply first matching rule to a request, rules replaceable while serving by SetRules.
+type Limiter struct {
+	mu    sync.RWMutex
+	rules []Rule
+	store Store
+}
+
+// NewLimiter create limiter checking `rules` in order, using memory store when `store` is nil.
+func NewLimiter(store Store, rules ...Rule) *Limiter {
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	return &Limiter{rules: rules, store: store}
+}
+
+// SetRules replace rules checked in order. Bucket of rule kept at same index, method and path survive.
+func (l *Limiter) SetRules(rules ...Rule) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rules = rules
+}
+
+// Match return index of first rule matching request, false when request not limited.
+func (l *Limiter) Match(method, path string) (int, Rule, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for i, r := range l.rules {
+		if r.matches(method, path) {
+			return i, r, true
+		}
+	}
+	return -1, Rule{}, false
+}
+
+// Take take a token of rule `idx` for `identity`, identity ignored on rule counting by route.
+// Request allowed when rules replaced since Match no longer having rule `idx`.
+func (l *Limiter) Take(idx int, identity string, now time.Time) (Result, error) {
+	l.mu.RLock()
+	if idx < 0 || idx >= len(l.rules) {
+		l.mu.RUnlock()
+		return Result{Allowed: true}, nil
+	}
+	r := l.rules[idx]
+	l.mu.RUnlock()
+	key := fmt.Sprintf("%d|%s|%s", idx, r.Method, r.Path)
+	if r.By != ByRoute {
+		key += "|" + identity
+	}
+	return l.store.Take(key, r.Limit, now)
+}
+
+// ParseConfig read rules from `ratelimit` config entry.
+// Route rules under `routes` checked first, `default` rule applied to every other route.
+//
+//	ratelimit:
+//	  default: {requests: 300, period: 60, by: subject}
+//	  routes:
+//	    - {method: POST, path: /auth/token, requests: 10, period: 60, burst: 5, by: ip}
+func ParseConfig(conf map[string]interface{}) ([]Rule, error) {
+	rules := []Rule{}
+	if routes, ok := conf["routes"].([]interface{}); ok {
+		for i, route := range routes {
+			m, ok := toMap(route)
+			if !ok {
+				return nil, fmt.Errorf("ratelimit route %d is not a map", i)
+			}
+			r, err := parseRule(m)
+			if err != nil {
+				return nil, fmt.Errorf("ratelimit route %d: %v", i, err)
+			}
+			rules = append(rules, r)
+		}
+	}
+	if def, ok := toMap(conf["default"]); ok {
+		r, err := parseRule(def)
+		if err != nil {
+			return nil, fmt.Errorf("ratelimit default: %v", err)
+		}
+		r.Method, r.Path = "*", "*"
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+func parseRule(m map[string]interface{}) (Rule, error) {
+	r := Rule{By: BySubject}
+	r.Method, _ = m["method"].(string)
+	r.Path, _ = m["path"].(string)
+	if by, ok := m["by"].(string); ok && by != "" {
+		r.By = strings.ToLower(by)
+	}
+	if r.By != ByIP && r.By != BySubject && r.By != ByRoute {
+		return r, fmt.Errorf("unknown key %q, expect ip, subject or route", r.By)
+	}
+	requests, _ := m["requests"].(int)
+	period, _ := m["period"].(int)
+	burst, _ := m["burst"].(int)
+	if requests <= 0 || period <= 0 || burst < 0 {
+		return r, fmt.Errorf("requests and period must be positive")
+	}
+	r.Limit = Limit{Requests: requests, Period: time.Duration(period) * time.Second, Burst: burst}
+	return r, nil
+}
+
+func toMap(v interface{}) (map[string]interface{}, bool) {
+	switch m := v.(type) {
+	case map[string]interface{}:
+		return m, true
+	case map[interface{}]interface{}:
+		res := map[string]interface{}{}
+		for k, val := range m {
+			res[fmt.Sprint(k)] = val
+		}
+		return res, true
+	}
+	return nil, false
+}
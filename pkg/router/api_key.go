@@ -0,0 +1,34 @@
+package router
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/jwt"
+)
+
+// APIKeyIdentity caller identity resolved from `x-api-key` header.
+// Key only allowed on its site, site ID 0 being default site.
+type APIKeyIdentity struct {
+	Id     int
+	Name   string
+	SiteID int
+	Scopes []string
+}
+
+// claims of API key caller loaded into `BearerContext.SideLoad.BearerData`, API key never hold any role.
+func (k APIKeyIdentity) claims() jwt.JWTClaims {
+	return jwt.JWTClaims{
+		Subject:  "api-key:" + strconv.Itoa(k.Id),
+		ClientID: k.Name,
+		SiteID:   k.SiteID,
+	}
+}
+
+// resolveKey resolve `key` using `resolve`, key always invalid when there is no resolver.
+func resolveKey(resolve func(key string) (APIKeyIdentity, error), key string) (APIKeyIdentity, error) {
+	if resolve == nil {
+		return APIKeyIdentity{}, errors.New("api key not supported")
+	}
+	return resolve(key)
+}
@@ -0,0 +1,151 @@
+package router
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/jwt"
+
+	"github.com/labstack/echo/v4"
+)
+
+// HeaderAPIKey header carrying API key of gate hardware.
+const HeaderAPIKey = "x-api-key"
+
+// initAuthMiddleware guard routes registered through `HandleAuth`/`HandleAuthWith` using bearer token
+// issued by `pkg/jwt` (JWS or JWE), claims loaded into `BearerContext.SideLoad.BearerData`.
+// Caller must hold one of roles declared on route permission, and only allowed on its own site unless claiming all sites.
+// Every `tokenChecks` must pass, e.g. to reject revoked token.
+// Route declaring API key scopes also accept `x-api-key` header resolved by `resolveAPIKey`.
+// Always installed: with `jwt.enable` off bearer token rejected, so route only reachable by API key.
+func initAuthMiddleware(
+	server *echo.Echo,
+	conf *config.Config,
+	authHandlers [][]interface{},
+	tokenChecks []func(jwt.JWTClaims) error,
+	resolveAPIKey func(key string) (APIKeyIdentity, error),
+) {
+	var j jwt.IJWT
+	if conf.JWT.Enable {
+		j = jwt.New(conf.JWT, conf.Secrets.JWT)
+	}
+	permissions := map[string]Permission{}
+	for _, r := range authHandlers {
+		permission := Permission{}
+		if len(r) > 3 {
+			permission = r[3].(Permission)
+		}
+		permissions[strings.ToUpper(r[0].(string))+" "+r[1].(string)] = permission
+	}
+
+	server.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			permission, isAuth := permissions[c.Request().Method+" "+c.Path()]
+			if !isAuth {
+				return next(c)
+			}
+			bc := contexts.EnsureBearerContext(c)
+
+			token := bearerToken(c.Request().Header.Get(echo.HeaderAuthorization))
+			apiKey := strings.TrimSpace(c.Request().Header.Get(HeaderAPIKey))
+			var claims jwt.JWTClaims
+
+			switch {
+			case token == "" && apiKey != "" && permission.acceptAPIKey():
+				identity, err := resolveKey(resolveAPIKey, apiKey)
+				if err != nil {
+					return bc.JSON(errs.InvalidToken, errs.NewErrContext().
+						SetCode(errs.RequestMissingAPIKey).
+						SetHttpCode(errs.InvalidToken).
+						SetMessage("Invalid API Key"))
+				}
+				if !permission.allowsScope(identity.Scopes) {
+					return bc.JSON(errs.Forbidden, errs.NewErrContext().
+						SetCode(errs.RequestNotAllowed).
+						SetHttpCode(errs.Forbidden).
+						SetMessage("Request Not Allowed For This API Key"))
+				}
+				claims = identity.claims()
+
+			case token == "" && permission.acceptAPIKey():
+				return bc.JSON(errs.InvalidToken, errs.NewErrContext().
+					SetCode(errs.RequestMissingAPIKey).
+					SetHttpCode(errs.InvalidToken).
+					SetMessage("Missing Bearer Token Or API Key"))
+
+			case token == "":
+				return bc.JSON(errs.InvalidToken, errs.NewErrContext().
+					SetCode(errs.RequestMissingBearer).
+					SetHttpCode(errs.InvalidToken).
+					SetMessage("Missing Bearer Token"))
+
+			case j == nil:
+				return bc.JSON(errs.InvalidToken, errs.NewErrContext().
+					SetCode(errs.RequestMissingBearer).
+					SetHttpCode(errs.InvalidToken).
+					SetMessage("Bearer Token Not Accepted"))
+
+			default:
+				var err error
+				claims, err = j.Serialize(token)
+				if err == nil {
+					err = j.Assert(claims)
+				}
+				if err == nil && claims.ExpiredAt != 0 && time.Now().Unix() >= claims.ExpiredAt {
+					err = errors.New("token is expired")
+				}
+				for _, check := range tokenChecks {
+					if err != nil {
+						break
+					}
+					err = check(claims)
+				}
+				if err != nil {
+					return bc.JSON(errs.InvalidToken, errs.NewErrContext().
+						SetCode(errs.RequestMissingBearer).
+						SetHttpCode(errs.InvalidToken).
+						SetMessage("Invalid Bearer Token"))
+				}
+				if !permission.allows(claims.Roles) {
+					return bc.JSON(errs.Forbidden, errs.NewErrContext().
+						SetCode(errs.RequestNotAllowed).
+						SetHttpCode(errs.Forbidden).
+						SetMessage("Request Not Allowed For This Role"))
+				}
+			}
+
+			if siteID, ok := siteParam(c); ok && !claims.AllSites && siteID != claims.SiteID {
+				return bc.JSON(errs.Forbidden, errs.NewErrContext().
+					SetCode(errs.RequestNotAllowed).
+					SetHttpCode(errs.Forbidden).
+					SetMessage("Request Not Allowed For This Site"))
+			}
+
+			bc.SideLoad.BearerData = claims
+			return next(bc)
+		}
+	})
+}
+
+// siteParam site ID of route param `siteId`, false when route not having it.
+func siteParam(c echo.Context) (int, bool) {
+	param := c.Param("siteId")
+	if param == "" {
+		return 0, false
+	}
+	siteID, _ := contexts.ParseSiteID(param)
+	return siteID, true
+}
+
+// bearerToken extract token from `Authorization: Bearer <token>` header value.
+func bearerToken(header string) string {
+	const prefix = "bearer "
+	if len(header) <= len(prefix) || strings.ToLower(header[:len(prefix)]) != prefix {
+		return ""
+	}
+	return strings.TrimSpace(header[len(prefix):])
+}
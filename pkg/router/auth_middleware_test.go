@@ -0,0 +1,184 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/jwt"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AuthMiddlewareTestSuite struct {
+	suite.Suite
+	config  map[string]map[string]interface{}
+	revoked map[string]bool
+	apiKeys map[string]APIKeyIdentity
+}
+
+func (s *AuthMiddlewareTestSuite) SetupTest() {
+	s.revoked = map[string]bool{}
+	s.apiKeys = map[string]APIKeyIdentity{
+		"gate-in":  {Id: 1, Name: "gate-in", SiteID: 2, Scopes: []string{"parking-in"}},
+		"gate-out": {Id: 2, Name: "gate-out", SiteID: 2, Scopes: []string{"parking-out"}},
+	}
+	s.config = map[string]map[string]interface{}{
+		"jwt": {
+			"signing_method": "HS256",
+			"enable":         true,
+			"duration":       60,
+		},
+		"secrets": {
+			"jwt": map[string]interface{}{
+				"key": "NRKqQdQ9pE0NLDPeUshePA==",
+			},
+		},
+		"server": {
+			"middlewares": []interface{}{"recover", "auth"},
+		},
+	}
+}
+
+func (s *AuthMiddlewareTestSuite) token(siteId int, roles ...string) string {
+	return s.tokenOf(jwt.JWTClaims{SiteID: siteId, Roles: roles})
+}
+
+// tokenOf token of `claims` site and roles
+func (s *AuthMiddlewareTestSuite) tokenOf(claims jwt.JWTClaims) string {
+	token, err := jwt.NewJWT(s.config).WithStructClaims(jwt.JWTClaims{
+		JWTID:     "1",
+		ClientID:  "test",
+		Subject:   "operator-1",
+		Audience:  "parking-management",
+		SessionID: "1",
+		IssuedAt:  time.Now().Unix(),
+		ExpiredAt: time.Now().Add(time.Minute).Unix(),
+		SiteID:    claims.SiteID,
+		AllSites:  claims.AllSites,
+		Roles:     claims.Roles,
+	})
+	s.NoError(err, "token must be generated")
+	return token
+}
+
+func (s *AuthMiddlewareTestSuite) serve(method, path, token string) *httptest.ResponseRecorder {
+	return s.serveWithHeader(method, path, token, "")
+}
+
+func (s *AuthMiddlewareTestSuite) serveWithHeader(method, path, token, apiKey string) *httptest.ResponseRecorder {
+	server := NewEchoServerV2(newConfig(s.config))
+	server.UseTokenCheck(func(claims jwt.JWTClaims) error {
+		if s.revoked[claims.JWTID] {
+			return errors.New("token is revoked")
+		}
+		return nil
+	})
+	handler := func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+		return bc.JSON(http.StatusOK, bc.GetRoles())
+	}
+	server.Handle("GET", "/lots", handler)
+	server.HandleAuthWith("DELETE", "/lots", Permission{Roles: []string{"admin"}}, handler)
+	server.HandleAuthWith("DELETE", "/sites/:siteId/lots", Permission{Roles: []string{"admin"}}, handler)
+	server.HandleAuthWith("POST", "/sites/:siteId/parking-in", Permission{Roles: []string{"admin"}, Scopes: []string{"parking-in"}}, handler)
+	server.UseAPIKeyResolver(func(key string) (APIKeyIdentity, error) {
+		identity, ok := s.apiKeys[key]
+		if !ok {
+			return identity, errors.New("invalid api key")
+		}
+		return identity, nil
+	})
+
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if apiKey != "" {
+		req.Header.Set(HeaderAPIKey, apiKey)
+	}
+	rec := httptest.NewRecorder()
+	server.GetServer().ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *AuthMiddlewareTestSuite) TestPublicRoute() {
+	s.Equal(http.StatusOK, s.serve("GET", "/lots", "").Code, "public route must not require token")
+}
+
+func (s *AuthMiddlewareTestSuite) TestMissingOrInvalidToken() {
+	s.Equal(http.StatusUnauthorized, s.serve("DELETE", "/lots", "").Code, "missing token must be rejected")
+	s.Equal(http.StatusUnauthorized, s.serve("DELETE", "/lots", "not-a-token").Code, "invalid token must be rejected")
+}
+
+func (s *AuthMiddlewareTestSuite) TestTokenCheck() {
+	token := s.token(0, "admin")
+	s.Equal(http.StatusOK, s.serve("DELETE", "/lots", token).Code, "token must pass before revoked")
+
+	s.revoked["1"] = true
+	s.Equal(http.StatusUnauthorized, s.serve("DELETE", "/lots", token).Code, "revoked token must be rejected")
+}
+
+func (s *AuthMiddlewareTestSuite) TestRolePermission() {
+	s.Equal(http.StatusForbidden, s.serve("DELETE", "/lots", s.token(0, "auditor")).Code, "role not allowed must be forbidden")
+
+	rec := s.serve("DELETE", "/lots", s.token(0, "auditor", "admin"))
+	s.Equal(http.StatusOK, rec.Code, "allowed role must pass")
+	s.Contains(rec.Body.String(), "admin", "claims must be loaded into bearer context")
+}
+
+func (s *AuthMiddlewareTestSuite) TestSiteBinding() {
+	s.Equal(http.StatusOK, s.serve("DELETE", "/sites/2/lots", s.token(2, "admin")).Code, "token bound to same site must pass")
+	s.Equal(http.StatusForbidden, s.serve("DELETE", "/sites/3/lots", s.token(2, "admin")).Code, "token bound to other site must be forbidden")
+	s.Equal(http.StatusForbidden, s.serve("DELETE", "/sites/3/lots", s.token(0, "admin")).Code, "default site token bound to default site")
+	s.Equal(http.StatusOK, s.serve("DELETE", "/sites/0/lots", s.token(0, "admin")).Code)
+	s.Equal(http.StatusOK, s.serve("DELETE", "/lots", s.token(2, "admin")).Code, "route without site param serve site of token")
+
+	allSites := s.tokenOf(jwt.JWTClaims{AllSites: true, Roles: []string{"admin"}})
+	s.Equal(http.StatusOK, s.serve("DELETE", "/sites/3/lots", allSites).Code, "all sites token may access any site")
+
+	s.Equal(http.StatusBadRequest, s.serve("DELETE", "/sites/abc/lots", allSites).Code, "invalid site never read as default site")
+	s.Equal(http.StatusBadRequest, s.serve("DELETE", "/sites/-1/lots", s.token(0, "admin")).Code)
+	s.Equal(http.StatusForbidden, s.serveWithHeader("POST", "/sites/0/parking-in", "", "gate-in").Code, "key bound to its site only")
+}
+
+func (s *AuthMiddlewareTestSuite) TestAPIKey() {
+	s.Equal(http.StatusOK, s.serveWithHeader("POST", "/sites/2/parking-in", "", "gate-in").Code, "key with route scope must pass")
+	s.Equal(http.StatusForbidden, s.serveWithHeader("POST", "/sites/2/parking-in", "", "gate-out").Code, "key without route scope must be forbidden")
+	s.Equal(http.StatusForbidden, s.serveWithHeader("POST", "/sites/3/parking-in", "", "gate-in").Code, "key bound to other site must be forbidden")
+	s.Equal(http.StatusUnauthorized, s.serveWithHeader("DELETE", "/sites/2/lots", "", "gate-in").Code, "route without scopes must not accept key")
+
+	rec := s.serveWithHeader("POST", "/sites/2/parking-in", "", "unknown")
+	s.Equal(http.StatusUnauthorized, rec.Code, "unknown key must be rejected")
+	s.Contains(rec.Body.String(), "131", "invalid key must use missing api key code")
+
+	rec = s.serveWithHeader("POST", "/sites/2/parking-in", "", "")
+	s.Equal(http.StatusUnauthorized, rec.Code, "missing credential must be rejected")
+	s.Contains(rec.Body.String(), "131", "route accepting key must use missing api key code")
+}
+
+func (s *AuthMiddlewareTestSuite) TestJWTDisabled() {
+	token := s.token(2, "admin")
+	s.config["jwt"]["enable"] = false
+
+	s.Equal(http.StatusUnauthorized, s.serve("DELETE", "/lots", "").Code, "auth route never served unauthenticated")
+	s.Equal(http.StatusUnauthorized, s.serve("DELETE", "/lots", token).Code, "bearer token not accepted")
+	s.Equal(http.StatusOK, s.serveWithHeader("POST", "/sites/2/parking-in", "", "gate-in").Code, "API key still checked")
+	s.Equal(http.StatusUnauthorized, s.serveWithHeader("POST", "/sites/2/parking-in", "", "unknown").Code)
+
+	s.config["server"]["middlewares"] = []interface{}{"recover"}
+	s.Equal(http.StatusUnauthorized, s.serve("DELETE", "/lots", "").Code, "auth installed even when not listed")
+}
+
+func (s *AuthMiddlewareTestSuite) TestPermissionAllows() {
+	s.True(Permission{}.allows(nil), "empty permission allow any caller")
+	s.True(Permission{Roles: []string{"admin", "operator"}}.allows([]string{"operator"}), "one of roles must be enough")
+	s.False(Permission{Roles: []string{"admin"}}.allows([]string{"gate-device"}), "other role must not be allowed")
+}
+
+func TestAuthMiddlewareSuite(t *testing.T) {
+	suite.Run(t, new(AuthMiddlewareTestSuite))
+}
@@ -1,10 +1,10 @@
 package router
 
 import (
-	"errors"
 	"regexp"
 	"strings"
 
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
 	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
 	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
 	"github.com/mhaikalla/parking-service-management-library/pkg/logs"
@@ -57,6 +57,10 @@ func createSkippedHandler(routes [][]interface{}) func(echo.Context) bool {
 			return true
 		}
 
+		// route pattern first so route with params like `/sites/:siteId/...` can be skipped
+		if _, isOk := mapRoutes[m][c.Path()]; isOk {
+			return true
+		}
 		_, isOk := mapRoutes[m][p]
 
 		return isOk
@@ -64,34 +68,12 @@ func createSkippedHandler(routes [][]interface{}) func(echo.Context) bool {
 }
 
 // initJWTMiddleware init echo jwt middleware using config
-func initJWTMiddleware(server *echo.Echo, config map[string]map[string]interface{}, skippedHandler [][]interface{}) {
-
-	var signingMethod string
-	var signingKey string
-	var enable bool
-
-	if conf, found := config["jwt"]; found {
-		enable = conf["enable"].(bool)
-		signingMethod = conf["signing_method"].(string)
-	} else {
-		panic(errors.New("No configuration key jwt found"))
-	}
-
-	if conf, found := config["secrets"]; found {
-		if secConf, found := conf["jwt"].(map[string]interface{}); found {
-			signingKey = secConf["key"].(string)
-		} else {
-			panic(errors.New("No configuration key jwt found in secrets entry"))
-		}
-	} else {
-		panic(errors.New("No configuration key secrets found"))
-	}
-
-	if enable {
+func initJWTMiddleware(server *echo.Echo, conf *config.Config, skippedHandler [][]interface{}) {
+	if conf.JWT.Enable {
 		skipper := createSkippedHandler(skippedHandler)
 		jc := middleware.JWTConfig{
-			SigningKey:    []byte(signingKey),
-			SigningMethod: signingMethod,
+			SigningKey:    []byte(conf.Secrets.JWT.Key),
+			SigningMethod: conf.JWT.SigningMethod,
 			Skipper:       skipper,
 		}
 		server.Use(middleware.JWTWithConfig(jc))
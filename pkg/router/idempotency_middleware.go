@@ -0,0 +1,131 @@
+package router
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+	"github.com/mhaikalla/parking-service-management-library/pkg/idempotency"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// HeaderIdempotencyKey header client set to make retried request safe.
+	HeaderIdempotencyKey = "Idempotency-Key"
+	// HeaderIdempotentReplayed header set on response replayed from stored one.
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	// idempotencyLockTimeout how long a request in progress hold its key, so crashed request not block retry forever.
+	idempotencyLockTimeout  = 30 * time.Second
+	idempotencyMaxKeyLength = 255
+)
+
+// initIdempotencyMiddleware replay first response of request sent with `Idempotency-Key` header.
+// Key scoped to caller, so listed after `auth` middleware key of different subjects never collide.
+// Retry with different method, path or body rejected with conflict, server error response never stored.
+// Records kept on `store`, default on json table of `file_storage`.
+func initIdempotencyMiddleware(server *echo.Echo, conf *config.Config, store idempotency.Store) {
+	ttl := 24 * time.Hour
+	if conf.Idempotency.TTL > 0 {
+		ttl = time.Duration(conf.Idempotency.TTL) * time.Second
+	}
+	methods := map[string]bool{}
+	for _, method := range conf.Idempotency.Methods {
+		methods[strings.ToUpper(method)] = true
+	}
+	if store == nil {
+		store = idempotency.NewFileStore(file.NewFileSystem(conf.FileStorage.Path))
+	}
+
+	server.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := c.Request().Header.Get(HeaderIdempotencyKey)
+			if key == "" || !methods[c.Request().Method] {
+				return next(c)
+			}
+			if len(key) > idempotencyMaxKeyLength {
+				return c.JSON(errs.BadRequest, errs.NewErrContext().
+					SetCode(errs.BadRequest).
+					SetMessage("Idempotency Key Too Long"))
+			}
+
+			body, err := io.ReadAll(c.Request().Body)
+			if err != nil {
+				return c.JSON(errs.BadRequest, errs.NewErrContext().
+					SetCode(errs.RequestBodyMalformed).
+					SetHttpCode(errs.BadRequest).
+					SetError(err))
+			}
+			c.Request().Body = io.NopCloser(bytes.NewReader(body))
+
+			now := time.Now().UTC()
+			rec := idempotency.Record{
+				Key:         idempotency.Hash([]byte(callerIdentity(c)), []byte(key)),
+				RequestHash: idempotency.Hash([]byte(c.Request().Method), []byte(c.Request().URL.Path), body),
+				CreatedAt:   now,
+				ExpiredAt:   now.Add(idempotencyLockTimeout),
+			}
+			stored, err := store.Reserve(rec, now)
+			if err != nil {
+				return c.JSON(errs.InternalServerError, errs.NewErrContext().
+					SetCode(errs.InternalServerError).
+					SetError(err))
+			}
+			if stored != nil {
+				return replayIdempotent(c, rec, *stored)
+			}
+
+			capture := &captureWriter{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = capture
+			errNext := next(c)
+			c.Response().Writer = capture.ResponseWriter
+
+			if errNext != nil || c.Response().Status >= http.StatusInternalServerError {
+				_ = store.Release(rec.Key)
+				return errNext
+			}
+			rec.Completed = true
+			rec.StatusCode = c.Response().Status
+			rec.ContentType = c.Response().Header().Get(echo.HeaderContentType)
+			rec.Body = capture.buff.Bytes()
+			rec.ExpiredAt = now.Add(ttl)
+			if err := store.Complete(rec); err != nil {
+				c.Logger().Error(err)
+			}
+			return nil
+		}
+	})
+}
+
+// replayIdempotent respond request whose key already used.
+func replayIdempotent(c echo.Context, rec, stored idempotency.Record) error {
+	if stored.RequestHash != rec.RequestHash {
+		return c.JSON(errs.Conflict, errs.NewErrContext().
+			SetCode(errs.Conflict).
+			SetMessage("Idempotency Key Already Used For Different Request"))
+	}
+	if !stored.Completed {
+		return c.JSON(errs.Conflict, errs.NewErrContext().
+			SetCode(errs.Conflict).
+			SetMessage("Request With This Idempotency Key Still In Progress"))
+	}
+	c.Response().Header().Set(HeaderIdempotentReplayed, "true")
+	return c.Blob(stored.StatusCode, stored.ContentType, stored.Body)
+}
+
+// captureWriter keep copy of response body written.
+type captureWriter struct {
+	http.ResponseWriter
+	buff bytes.Buffer
+}
+
+func (w *captureWriter) Write(b []byte) (int, error) {
+	w.buff.Write(b)
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,78 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/file"
+	"github.com/mhaikalla/parking-service-management-library/pkg/idempotency"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type IdempotencyMiddlewareTestSuite struct {
+	suite.Suite
+	server ServerV2
+	calls  int
+}
+
+func (s *IdempotencyMiddlewareTestSuite) SetupTest() {
+	s.calls = 0
+	s.server = NewEchoServerV2(newConfig(map[string]map[string]interface{}{
+		"server": {
+			"middlewares": []interface{}{"idempotency"},
+		},
+	}))
+	s.server.UseIdempotencyStore(idempotency.NewFileStore(file.NewFileSystem(s.T().TempDir() + "/")))
+	s.server.Handle("POST", "/parking-out", func(i interface{}) error {
+		s.calls++
+		bc := i.(contexts.BearerContext)
+		if s.calls > 1 {
+			return bc.JSON(http.StatusBadRequest, "This vehicle has left the parking lot")
+		}
+		return bc.JSON(http.StatusOK, map[string]int{"price": 5000})
+	})
+}
+
+func (s *IdempotencyMiddlewareTestSuite) serve(key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", "/parking-out", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(HeaderIdempotencyKey, key)
+	}
+	rec := httptest.NewRecorder()
+	s.server.GetServer().ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *IdempotencyMiddlewareTestSuite) TestReplay() {
+	first := s.serve("key-1", `{"plat_nomor":"B1"}`)
+	s.Equal(http.StatusOK, first.Code)
+
+	retry := s.serve("key-1", `{"plat_nomor":"B1"}`)
+	s.Equal(http.StatusOK, retry.Code, "retry must replay first response")
+	s.Equal(first.Body.String(), retry.Body.String(), "replayed body must be the same")
+	s.Equal("true", retry.Header().Get(HeaderIdempotentReplayed))
+	s.Equal(1, s.calls, "handler must run once")
+}
+
+func (s *IdempotencyMiddlewareTestSuite) TestDifferentBody() {
+	s.serve("key-1", `{"plat_nomor":"B1"}`)
+	rec := s.serve("key-1", `{"plat_nomor":"B2"}`)
+	s.Equal(http.StatusConflict, rec.Code, "reused key with different body must conflict")
+	s.Equal(1, s.calls)
+}
+
+func (s *IdempotencyMiddlewareTestSuite) TestWithoutKey() {
+	s.serve("", `{"plat_nomor":"B1"}`)
+	rec := s.serve("", `{"plat_nomor":"B1"}`)
+	s.Equal(http.StatusBadRequest, rec.Code, "request without key not replayed")
+	s.Equal(2, s.calls)
+}
+
+func TestIdempotencyMiddlewareSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyMiddlewareTestSuite))
+}
@@ -2,8 +2,14 @@ package router
 
 import (
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/payloadhooks"
+
 	"github.com/stretchr/testify/suite"
 )
 
@@ -46,6 +52,51 @@ func (s *HandlerIfaceTestSuite) TestImplementIface() {
 
 }
 
+func (s *HandlerIfaceTestSuite) TestRoutesListed() {
+	server := NewEchoServerV2(newConfig(map[string]map[string]interface{}{}))
+	h := func(interface{}) error { return nil }
+	admin := Permission{Roles: []string{"admin"}}
+
+	server.HandleAuthWith("POST", "/private", admin, h)
+	server.Handle("GET", "/public", h)
+
+	s.Equal([]RouteInfo{
+		{Method: "GET", Path: "/public"},
+		{Method: "POST", Path: "/private", Auth: true, Permission: admin},
+	}, server.Routes(), "routes must be listed with their permission")
+}
+
+func (s *HandlerIfaceTestSuite) TestPayloadCryptoReloaded() {
+	defer payloadhooks.SetPayloadCryptoFeature(payloadhooks.PayloadCryptoEnabled())
+	server := NewEchoServerV2(newConfig(map[string]map[string]interface{}{}))
+	server.Handle("GET", "/lots", func(i interface{}) error {
+		return i.(contexts.BearerContext).JSON(http.StatusOK, map[string]interface{}{"name": "A1"})
+	})
+	serve := func() string {
+		rec := httptest.NewRecorder()
+		server.GetServer().ServeHTTP(rec, httptest.NewRequest("GET", "/lots", nil))
+		s.Equal(http.StatusOK, rec.Code)
+		return rec.Body.String()
+	}
+
+	newConfig(map[string]map[string]interface{}{"payload_crypto": {"enable": true}}).ApplyReloadable()
+	s.Contains(serve(), `"xdata"`, "payload crypto enabled by reload")
+
+	newConfig(map[string]map[string]interface{}{"payload_crypto": {"enable": false}}).ApplyReloadable()
+	body := serve()
+	s.NotContains(body, `"xdata"`, "payload crypto disabled by reload")
+	s.Contains(body, `"A1"`)
+}
+
 func TestHandlerIfaceSuite(t *testing.T) {
 	suite.Run(t, new(HandlerIfaceTestSuite))
 }
+
+// newConfig typed config of `raw` entries, partial entries of test not validated.
+func newConfig(raw map[string]map[string]interface{}) *config.Config {
+	conf, err := config.NewConfig(raw)
+	if err != nil {
+		panic(err)
+	}
+	return conf
+}
@@ -0,0 +1,49 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/lifecycle"
+
+	"github.com/labstack/echo/v4"
+)
+
+// initLifecycleMiddleware track request mutating storage as in-flight write of `manager`, so shutdown wait for it.
+// Request arriving once shutdown started rejected with 503, client expected to retry on other instance.
+func initLifecycleMiddleware(server *echo.Echo, manager *lifecycle.Manager) {
+	server.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if !isWriteMethod(req.Method) {
+				if manager.Draining() {
+					return rejectDraining(c)
+				}
+				return next(c)
+			}
+
+			done, err := manager.Begin(lifecycle.KindWrite, req.Method+" "+req.RequestURI)
+			if err != nil {
+				return rejectDraining(c)
+			}
+			defer done()
+			return next(c)
+		}
+	})
+}
+
+func isWriteMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+func rejectDraining(c echo.Context) error {
+	c.Response().Header().Set(echo.HeaderConnection, "close")
+	return c.JSON(http.StatusServiceUnavailable, errs.NewErrContext().
+		SetCode(errs.ServiceUnavailable).
+		SetHttpCode(http.StatusServiceUnavailable).
+		SetMessage("Service Shutting Down, Retry Later"))
+}
@@ -0,0 +1,65 @@
+package router
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/lifecycle"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LifecycleMiddlewareTestSuite struct {
+	suite.Suite
+}
+
+func (s *LifecycleMiddlewareTestSuite) TestTrackWriteAndRejectWhenDraining() {
+	manager := lifecycle.New()
+	server := NewEchoServerV2(newConfig(map[string]map[string]interface{}{}))
+	server.UseLifecycle(manager)
+
+	inHandler := make(chan struct{})
+	release := make(chan struct{})
+	server.Handle("POST", "/parking-in", func(i interface{}) error {
+		close(inHandler)
+		<-release
+		return i.(contexts.BearerContext).JSON(http.StatusOK, "ok")
+	})
+	server.Handle("GET", "/floors", func(i interface{}) error {
+		return i.(contexts.BearerContext).JSON(http.StatusOK, "ok")
+	})
+	e := server.GetServer()
+
+	written := httptest.NewRecorder()
+	go e.ServeHTTP(written, httptest.NewRequest("POST", "/parking-in", nil))
+	<-inHandler
+	s.Len(manager.InFlight(), 1, "write tracked while handled")
+	s.Equal("POST /parking-in", manager.InFlight()[0].Name)
+
+	shutdown := make(chan lifecycle.Report)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		shutdown <- manager.Shutdown(ctx)
+	}()
+	s.Eventually(manager.Draining, time.Second, time.Millisecond)
+
+	for _, req := range []*http.Request{httptest.NewRequest("GET", "/floors", nil), httptest.NewRequest("POST", "/parking-in", nil)} {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		s.Equal(http.StatusServiceUnavailable, rec.Code, req.Method)
+	}
+
+	close(release)
+	report := <-shutdown
+	s.Empty(report.Abandoned, "shutdown wait running write")
+	s.Equal(http.StatusOK, written.Code)
+}
+
+func TestLifecycleMiddlewareSuite(t *testing.T) {
+	suite.Run(t, new(LifecycleMiddlewareTestSuite))
+}
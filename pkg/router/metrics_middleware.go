@@ -0,0 +1,47 @@
+package router
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/metrics"
+
+	"github.com/labstack/echo/v4"
+)
+
+// routeUnmatched route label of request not matching any route, keep unknown paths from growing series.
+const routeUnmatched = "unmatched"
+
+var (
+	httpRequests = metrics.Default.NewCounter("http_requests_total",
+		"HTTP requests served by method, route pattern and status code.", "method", "route", "status")
+	httpRequestDuration = metrics.Default.NewHistogram("http_request_duration_seconds",
+		"Latency of HTTP requests by method and route pattern.", nil, "method", "route")
+)
+
+// initMetricsMiddleware count and time every request by route pattern, listed first so rejected requests counted too.
+func initMetricsMiddleware(server *echo.Echo) {
+	server.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+
+			status := c.Response().Status
+			if err != nil && !c.Response().Committed {
+				status = http.StatusInternalServerError
+				if he, ok := err.(*echo.HTTPError); ok {
+					status = he.Code
+				}
+			}
+			route := c.Path()
+			if route == "" || status == http.StatusNotFound && route == "/*" {
+				route = routeUnmatched
+			}
+			method := c.Request().Method
+			httpRequests.Inc(method, route, strconv.Itoa(status))
+			httpRequestDuration.Observe(time.Since(start).Seconds(), method, route)
+			return err
+		}
+	})
+}
@@ -0,0 +1,43 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MetricsMiddlewareTestSuite struct {
+	suite.Suite
+}
+
+func (s *MetricsMiddlewareTestSuite) TestCountByRoutePattern() {
+	server := NewEchoServerV2(newConfig(map[string]map[string]interface{}{
+		"server": {
+			"middlewares": []interface{}{"metrics"},
+		},
+	}))
+	server.Handle("GET", "/lots/:id", func(i interface{}) error {
+		return i.(contexts.BearerContext).JSON(http.StatusCreated, "ok")
+	})
+	e := server.GetServer()
+
+	okBefore := httpRequests.Value("GET", "/lots/:id", "201")
+	unmatchedBefore := httpRequests.Value("GET", routeUnmatched, "404")
+	timedBefore := httpRequestDuration.Count("GET", "/lots/:id")
+
+	for _, path := range []string{"/lots/1", "/lots/2", "/missing"} {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", path, nil))
+	}
+
+	s.Equal(okBefore+2, httpRequests.Value("GET", "/lots/:id", "201"), "request must be counted by route pattern")
+	s.Equal(unmatchedBefore+1, httpRequests.Value("GET", routeUnmatched, "404"), "unknown path must share one series")
+	s.Equal(timedBefore+2, httpRequestDuration.Count("GET", "/lots/:id"))
+}
+
+func TestMetricsMiddlewareSuite(t *testing.T) {
+	suite.Run(t, new(MetricsMiddlewareTestSuite))
+}
@@ -0,0 +1,47 @@
+package router
+
+// Permission declare who may call an authenticated route. Empty permission allow any caller holding bearer token.
+type Permission struct {
+	// Roles allowed to call the route, caller must have at least one of them.
+	Roles []string
+	// Scopes of API key allowed to call the route, route not accept API key when empty.
+	Scopes []string
+}
+
+// IHandleRegisterV3 consisting IHandleRegisterV2 and add method HandleAuthWith to declare permission per route
+type IHandleRegisterV3 interface {
+	IHandleRegisterV2
+	HandleAuthWith(method, path string, permission Permission, handler func(interface{}) error)
+}
+
+// allows check whether one of `roles` permitted.
+func (p Permission) allows(roles []string) bool {
+	if len(p.Roles) == 0 {
+		return true
+	}
+	for _, allowed := range p.Roles {
+		for _, role := range roles {
+			if role == allowed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// acceptAPIKey check whether route can be called with API key.
+func (p Permission) acceptAPIKey() bool {
+	return len(p.Scopes) > 0
+}
+
+// allowsScope check whether one of API key `scopes` permitted.
+func (p Permission) allowsScope(scopes []string) bool {
+	for _, allowed := range p.Scopes {
+		for _, scope := range scopes {
+			if scope == allowed {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package router
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/ratelimit"
+
+	"github.com/labstack/echo/v4"
+)
+
+// initRateLimitMiddleware limit requests using rules of `ratelimit` config entry, buckets kept in `store`.
+// Request counted by subject only known when listed after `auth` middleware, otherwise counted by client IP.
+// Client over its own limit get `RequestLimited` (121), route over its shared limit get `RequestLimitReached` (122).
+// Limiter returned so rules replaced on config reload.
+func initRateLimitMiddleware(server *echo.Echo, conf *config.Config, store ratelimit.Store) *ratelimit.Limiter {
+	if conf.RateLimit == nil {
+		panic(errors.New("No configuration key ratelimit found"))
+	}
+	rules, err := ratelimit.ParseConfig(conf.RateLimit)
+	if err != nil {
+		panic(err)
+	}
+	limiter := ratelimit.NewLimiter(store, rules...)
+
+	server.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			idx, rule, ok := limiter.Match(c.Request().Method, c.Path())
+			if !ok {
+				return next(c)
+			}
+
+			res, err := limiter.Take(idx, rateLimitIdentity(c, rule), time.Now())
+			if err != nil {
+				// limiter store unavailable should not take the service down
+				return next(c)
+			}
+
+			header := c.Response().Header()
+			header.Set("X-RateLimit-Limit", strconv.Itoa(res.Limit))
+			header.Set("X-RateLimit-Remaining", strconv.Itoa(res.Remaining))
+			header.Set("X-RateLimit-Reset", strconv.Itoa(ceilSeconds(res.ResetAfter)))
+			if res.Allowed {
+				return next(c)
+			}
+
+			header.Set("Retry-After", strconv.Itoa(ceilSeconds(res.RetryAfter)))
+			code, message := errs.RequestLimited, "Too Many Requests, Retry Later"
+			if rule.By == ratelimit.ByRoute {
+				code, message = errs.RequestLimitReached, "Request Limit Reached, Retry Later"
+			}
+			return c.JSON(http.StatusTooManyRequests, errs.NewErrContext().
+				SetCode(code).
+				SetHttpCode(http.StatusTooManyRequests).
+				SetMessage(message))
+		}
+	})
+	return limiter
+}
+
+// rateLimitIdentity identity request counted as, subject of authenticated caller or client IP.
+func rateLimitIdentity(c echo.Context, rule ratelimit.Rule) string {
+	if rule.By == ratelimit.BySubject {
+		return callerIdentity(c)
+	}
+	return "ip:" + c.RealIP()
+}
+
+// callerIdentity subject of authenticated caller, hash of API key sent, or client IP.
+func callerIdentity(c echo.Context) string {
+	if bc, ok := c.(contexts.BearerContext); ok && bc.SideLoad.BearerData.Subject != "" {
+		return "sub:" + bc.SideLoad.BearerData.Subject
+	}
+	if key := c.Request().Header.Get(HeaderAPIKey); key != "" {
+		sum := sha256.Sum256([]byte(key))
+		return "key:" + hex.EncodeToString(sum[:8])
+	}
+	return "ip:" + c.RealIP()
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,97 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitMiddlewareTestSuite struct {
+	suite.Suite
+}
+
+func (s *RateLimitMiddlewareTestSuite) server() ServerV2 {
+	server := NewEchoServerV2(newConfig(map[string]map[string]interface{}{
+		"server": {
+			"middlewares": []interface{}{"ratelimit"},
+		},
+		"ratelimit": {
+			"default": map[string]interface{}{"requests": 2, "period": 60, "by": "ip"},
+			"routes": []interface{}{
+				map[string]interface{}{"method": "GET", "path": "/reports", "requests": 1, "period": 60, "by": "route"},
+			},
+		},
+	}))
+	handler := func(i interface{}) error {
+		return i.(contexts.BearerContext).JSON(http.StatusOK, "ok")
+	}
+	server.Handle("GET", "/lots", handler)
+	server.Handle("GET", "/reports", handler)
+	return server
+}
+
+func (s *RateLimitMiddlewareTestSuite) serve(server ServerV2, path, ip string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("GET", path, nil)
+	req.Header.Set("X-Real-IP", ip)
+	rec := httptest.NewRecorder()
+	server.GetServer().ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *RateLimitMiddlewareTestSuite) TestLimitByIP() {
+	server := s.server()
+
+	rec := s.serve(server, "/lots", "10.0.0.1")
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal("2", rec.Header().Get("X-RateLimit-Limit"), "limit header must be sent")
+	s.Equal("1", rec.Header().Get("X-RateLimit-Remaining"), "remaining header must be sent")
+
+	s.Equal(http.StatusOK, s.serve(server, "/lots", "10.0.0.1").Code)
+	rec = s.serve(server, "/lots", "10.0.0.1")
+	s.Equal(http.StatusTooManyRequests, rec.Code, "third request must be limited")
+	s.Equal("30", rec.Header().Get("Retry-After"), "retry after next token refilled")
+	s.Contains(rec.Body.String(), `"121"`, "client limit use request limited code")
+
+	s.Equal(http.StatusOK, s.serve(server, "/lots", "10.0.0.2").Code, "other client not limited")
+}
+
+func (s *RateLimitMiddlewareTestSuite) TestLimitByRoute() {
+	server := s.server()
+
+	s.Equal(http.StatusOK, s.serve(server, "/reports", "10.0.0.1").Code)
+	rec := s.serve(server, "/reports", "10.0.0.2")
+	s.Equal(http.StatusTooManyRequests, rec.Code, "route limit shared by all clients")
+	s.Contains(rec.Body.String(), `"122"`, "route limit use request limit reached code")
+}
+
+func (s *RateLimitMiddlewareTestSuite) TestReload() {
+	server := s.server()
+	e := server.GetServer()
+	serve := func(ip string) int {
+		req := httptest.NewRequest("GET", "/reports", nil)
+		req.Header.Set("X-Real-IP", ip)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	s.Equal(http.StatusOK, serve("10.0.0.1"))
+	s.Equal(http.StatusTooManyRequests, serve("10.0.0.2"))
+
+	s.Error(server.Reload(newConfig(map[string]map[string]interface{}{
+		"ratelimit": {"default": map[string]interface{}{"requests": 0, "period": 60}},
+	})), "invalid rules rejected")
+	s.Equal(http.StatusTooManyRequests, serve("10.0.0.3"), "rules kept when reload rejected")
+
+	s.NoError(server.Reload(newConfig(map[string]map[string]interface{}{
+		"ratelimit": {"default": map[string]interface{}{"requests": 5, "period": 60, "by": "ip"}},
+	})))
+	s.Equal(http.StatusOK, serve("10.0.0.3"), "reloaded rules applied without restart")
+}
+
+func TestRateLimitMiddlewareSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitMiddlewareTestSuite))
+}
@@ -1,7 +1,12 @@
 package router
 
 import (
+	"github.com/mhaikalla/parking-service-management-library/pkg/config"
+	"github.com/mhaikalla/parking-service-management-library/pkg/idempotency"
 	"github.com/mhaikalla/parking-service-management-library/pkg/interceptors"
+	"github.com/mhaikalla/parking-service-management-library/pkg/jwt"
+	"github.com/mhaikalla/parking-service-management-library/pkg/lifecycle"
+	"github.com/mhaikalla/parking-service-management-library/pkg/ratelimit"
 
 	echo "github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -10,19 +15,41 @@ import (
 // EchoServerV2 version 2 of EchoServer context
 type EchoServerV2 struct {
 	server          *echo.Echo
-	config          map[string]map[string]interface{}
+	config          *config.Config
 	nonAuthHandlers [][]interface{}
 	authHandlers    [][]interface{}
+	tokenChecks     []func(jwt.JWTClaims) error
+	resolveAPIKey   func(key string) (APIKeyIdentity, error)
+	rateLimitStore  ratelimit.Store
+	limiter         *ratelimit.Limiter
+	idempotency     idempotency.Store
+	lifecycle       *lifecycle.Manager
 }
 
 // ServerV2 version 2 of Server interface
 type ServerV2 interface {
-	IHandleRegisterV2
+	IHandleRegisterV3
+	UseTokenCheck(check func(claims jwt.JWTClaims) error)
+	UseAPIKeyResolver(resolve func(key string) (APIKeyIdentity, error))
+	UseRateLimitStore(store ratelimit.Store)
+	UseIdempotencyStore(store idempotency.Store)
+	UseLifecycle(manager *lifecycle.Manager)
+	Routes() []RouteInfo
 	GetServer() *echo.Echo
+	CheckReload(conf *config.Config) error
+	Reload(conf *config.Config) error
+}
+
+// RouteInfo route registered on server, used to describe the API
+type RouteInfo struct {
+	Method     string
+	Path       string
+	Auth       bool
+	Permission Permission
 }
 
 // NewEchoServerV2 version 2 of NewEchoServer
-func NewEchoServerV2(config map[string]map[string]interface{}) ServerV2 {
+func NewEchoServerV2(config *config.Config) ServerV2 {
 	server := echo.New()
 	server.HTTPErrorHandler = JSONErrorHandler
 	return &EchoServerV2{
@@ -41,8 +68,73 @@ func (ctx *EchoServerV2) Handle(method, path string, handler func(interface{}) e
 
 // HandleAuth method to add route config but must be authenticated
 func (ctx *EchoServerV2) HandleAuth(method, path string, handler func(interface{}) error) {
+	ctx.HandleAuthWith(method, path, Permission{}, handler)
+}
+
+// HandleAuthWith method to add route config that must be authenticated and satisfy `permission`
+func (ctx *EchoServerV2) HandleAuthWith(method, path string, permission Permission, handler func(interface{}) error) {
 	handerfunc := func(c echo.Context) error { return handler(c) }
-	ctx.authHandlers = append(ctx.authHandlers, []interface{}{method, path, handerfunc})
+	ctx.authHandlers = append(ctx.authHandlers, []interface{}{method, path, handerfunc, permission})
+}
+
+// UseTokenCheck add check run on bearer token claims of authenticated route, token rejected when check return error
+func (ctx *EchoServerV2) UseTokenCheck(check func(claims jwt.JWTClaims) error) {
+	ctx.tokenChecks = append(ctx.tokenChecks, check)
+}
+
+// UseAPIKeyResolver set resolver of `x-api-key` header used on route declaring API key scopes
+func (ctx *EchoServerV2) UseAPIKeyResolver(resolve func(key string) (APIKeyIdentity, error)) {
+	ctx.resolveAPIKey = resolve
+}
+
+// UseRateLimitStore set store of `ratelimit` middleware buckets, default kept in memory
+func (ctx *EchoServerV2) UseRateLimitStore(store ratelimit.Store) {
+	ctx.rateLimitStore = store
+}
+
+// UseIdempotencyStore set store of `idempotency` middleware records, default json table of `file_storage`
+func (ctx *EchoServerV2) UseIdempotencyStore(store idempotency.Store) {
+	ctx.idempotency = store
+}
+
+// UseLifecycle set manager tracking in-flight writes, every request rejected once it start shutting down
+func (ctx *EchoServerV2) UseLifecycle(manager *lifecycle.Manager) {
+	ctx.lifecycle = manager
+}
+
+// Routes list of registered routes, unauthenticated first then authenticated, each in registering order
+func (ctx *EchoServerV2) Routes() []RouteInfo {
+	routes := make([]RouteInfo, 0, len(ctx.nonAuthHandlers)+len(ctx.authHandlers))
+	for _, h := range ctx.nonAuthHandlers {
+		routes = append(routes, RouteInfo{Method: h[0].(string), Path: h[1].(string)})
+	}
+	for _, h := range ctx.authHandlers {
+		routes = append(routes, RouteInfo{Method: h[0].(string), Path: h[1].(string), Auth: true, Permission: h[3].(Permission)})
+	}
+	return routes
+}
+
+// CheckReload error when Reload can not apply `conf`, nothing applied.
+func (ctx *EchoServerV2) CheckReload(conf *config.Config) error {
+	if ctx.limiter == nil {
+		return nil
+	}
+	_, err := ratelimit.ParseConfig(conf.RateLimit)
+	return err
+}
+
+// Reload apply part of `conf` safe to change while serving, currently rules of `ratelimit` middleware.
+// Nothing replaced when rules invalid, see CheckReload.
+func (ctx *EchoServerV2) Reload(conf *config.Config) error {
+	if ctx.limiter == nil {
+		return nil
+	}
+	rules, err := ratelimit.ParseConfig(conf.RateLimit)
+	if err != nil {
+		return err
+	}
+	ctx.limiter.SetRules(rules...)
+	return nil
 }
 
 // GetServer function returning echo server
@@ -50,36 +142,59 @@ func (ctx *EchoServerV2) GetServer() *echo.Echo {
 	server := ctx.server
 	conf := ctx.config
 
-	if serverConf, ok := conf["server"]; ok {
-		d := serverConf["debug"].(bool)
-		server.Debug = d
-		server.HideBanner = true
+	// outermost so request rejected while shutting down never reach other middlewares
+	if ctx.lifecycle != nil {
+		initLifecycleMiddleware(server, ctx.lifecycle)
+	}
 
-		if middlewaresConf, ok := serverConf["middlewares"]; ok {
-			m := middlewaresConf.([]interface{})
-			for _, am := range m {
-				switch am.(string) {
+	server.Debug = conf.Server.Debug
+	server.HideBanner = true
 
-				case "attach_request_id":
-					server.Use(interceptors.AttachRequestID())
+	// before auth so invalid site never checked against site of caller
+	initSiteParamMiddleware(server)
 
-				case "log":
-					server.Use(middleware.Logger())
+	authInstalled := false
+	for _, am := range conf.Server.Middlewares {
+		switch am {
 
-				case "jwt":
-					initJWTMiddleware(server, conf, ctx.nonAuthHandlers)
+		case "metrics":
+			initMetricsMiddleware(server)
 
-				case "requestid":
-					server.Use(middleware.RequestID())
+		case "tracing":
+			initTracingMiddleware(server)
 
-				case "recover":
-					server.Use(middleware.Recover())
-				}
+		case "attach_request_id":
+			server.Use(interceptors.AttachRequestID())
 
-			}
+		case "log":
+			server.Use(middleware.Logger())
+
+		case "jwt":
+			initJWTMiddleware(server, conf, ctx.nonAuthHandlers)
+
+		case "auth":
+			initAuthMiddleware(server, conf, ctx.authHandlers, ctx.tokenChecks, ctx.resolveAPIKey)
+			authInstalled = true
+
+		case "ratelimit":
+			ctx.limiter = initRateLimitMiddleware(server, conf, ctx.rateLimitStore)
+
+		case "idempotency":
+			initIdempotencyMiddleware(server, conf, ctx.idempotency)
+
+		case "requestid":
+			server.Use(middleware.RequestID())
+
+		case "recover":
+			server.Use(middleware.Recover())
 		}
 	}
 
+	// route registered through `HandleAuth` never served unauthenticated even when `auth` middleware not listed
+	if !authInstalled && len(ctx.authHandlers) > 0 {
+		initAuthMiddleware(server, conf, ctx.authHandlers, ctx.tokenChecks, ctx.resolveAPIKey)
+	}
+
 	server.Use(interceptors.SetRequestValidationData())
 	iterateRoutes(server, ctx.nonAuthHandlers)
 	iterateRoutes(server, ctx.authHandlers)
@@ -0,0 +1,28 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+
+	"github.com/labstack/echo/v4"
+)
+
+// initSiteParamMiddleware reject route param `siteId` not being site ID with 400, so such request never served
+// as default site.
+func initSiteParamMiddleware(server *echo.Echo) {
+	server.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if param := c.Param("siteId"); param != "" {
+				if _, err := contexts.ParseSiteID(param); err != nil {
+					return c.JSON(http.StatusBadRequest, errs.NewErrContext().
+						SetCode(errs.BadRequest).
+						SetHttpCode(http.StatusBadRequest).
+						SetMessage("Invalid Site ID"))
+				}
+			}
+			return next(c)
+		}
+	})
+}
@@ -0,0 +1,56 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/logs"
+	"github.com/mhaikalla/parking-service-management-library/pkg/tracing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// initTracingMiddleware start server span of every request continuing `traceparent` of caller,
+// span carried by request context so usecase, storage and upstream call traced as its child.
+func initTracingMiddleware(server *echo.Echo) {
+	server.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			route := c.Path()
+			if route == "" {
+				route = routeUnmatched
+			}
+			ctx, span := tracing.StartKind(tracing.Extract(req.Context(), req.Header), req.Method+" "+route, tracing.SpanKindServer)
+			defer span.End()
+			span.SetAttribute("http.method", req.Method)
+			span.SetAttribute("http.route", route)
+			span.SetAttribute("http.target", req.RequestURI)
+
+			c.SetRequest(req.WithContext(ctx))
+			sc := span.SpanContext()
+			c.Response().Header().Set(tracing.HeaderTraceParent, sc.TraceParent())
+
+			bc := contexts.EnsureBearerContext(c)
+			bc.GetLogger().Upsert(logs.TraceID, sc.TraceID.String())
+			bc.GetLogger().Upsert(logs.SpanID, sc.SpanID.String())
+			bc.GetLogger().Update()
+
+			err := next(bc)
+
+			status := c.Response().Status
+			if err != nil && !c.Response().Committed {
+				status = http.StatusInternalServerError
+				if he, ok := err.(*echo.HTTPError); ok {
+					status = he.Code
+				}
+				span.RecordError(err)
+			}
+			span.SetAttribute("http.status_code", status)
+			if status >= http.StatusInternalServerError && err == nil {
+				span.RecordError(fmt.Errorf("%s", http.StatusText(status)))
+			}
+			return err
+		}
+	})
+}
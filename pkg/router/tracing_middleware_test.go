@@ -0,0 +1,66 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/contexts"
+	"github.com/mhaikalla/parking-service-management-library/pkg/tracing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TracingMiddlewareTestSuite struct {
+	suite.Suite
+}
+
+func (s *TracingMiddlewareTestSuite) TestContinueCallerTrace() {
+	out := &bytes.Buffer{}
+	previous := tracing.Default()
+	tracing.SetDefault(tracing.NewTracer("parking", tracing.NewStdoutExporter(out)))
+	defer tracing.SetDefault(previous)
+
+	server := NewEchoServerV2(newConfig(map[string]map[string]interface{}{
+		"server": {
+			"middlewares": []interface{}{"tracing"},
+		},
+	}))
+	server.Handle("GET", "/lots/:id", func(i interface{}) error {
+		bc := i.(contexts.BearerContext)
+		_, end := bc.StartSpan("UsecaseParkingLot.GetDetailParkingLot")
+		end()
+		return bc.JSON(http.StatusOK, "ok")
+	})
+	e := server.GetServer()
+
+	req := httptest.NewRequest("GET", "/lots/1", nil)
+	req.Header.Set(tracing.HeaderTraceParent, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	sc, err := tracing.ParseTraceParent(rec.Header().Get(tracing.HeaderTraceParent))
+	s.NoError(err, "server span returned to caller")
+	s.Equal("4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID.String())
+
+	var spans []tracing.SpanData
+	dec := json.NewDecoder(out)
+	for dec.More() {
+		var span tracing.SpanData
+		s.NoError(dec.Decode(&span))
+		spans = append(spans, span)
+	}
+	s.Len(spans, 2)
+	s.Equal("UsecaseParkingLot.GetDetailParkingLot", spans[0].Name)
+	s.Equal(sc.SpanID.String(), spans[0].ParentSpanID, "usecase span child of server span")
+	s.Equal("GET /lots/:id", spans[1].Name)
+	s.Equal(tracing.SpanKindServer, spans[1].Kind)
+	s.Equal("00f067aa0ba902b7", spans[1].ParentSpanID)
+	s.EqualValues(http.StatusOK, spans[1].Attributes["http.status_code"])
+}
+
+func TestTracingMiddlewareSuite(t *testing.T) {
+	suite.Run(t, new(TracingMiddlewareTestSuite))
+}
@@ -0,0 +1,123 @@
+package secrets
+
+import (
+	"crypto/aes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/crypts"
+)
+
+// Keystore local file of secrets encrypted as a whole by master key, AES as CryptoJS with key derived from master key.
+type Keystore struct {
+	path      string
+	masterKey string
+
+	mu     sync.RWMutex
+	values map[string]string
+}
+
+// OpenKeystore open keystore `path` encrypted by `masterKey`, empty keystore when file not exists yet.
+func OpenKeystore(path, masterKey string) (*Keystore, error) {
+	if masterKey == "" {
+		return nil, errors.New("keystore master key empty")
+	}
+	ks := &Keystore{path: path, masterKey: masterKey, values: map[string]string{}}
+	buff, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return ks, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cipherText := strings.TrimSpace(string(buff))
+	raw, err := base64.StdEncoding.DecodeString(cipherText)
+	// checked before decrypting, decrypt panic on truncated block
+	if err != nil || len(raw) < 2*aes.BlockSize || len(raw)%aes.BlockSize != 0 {
+		return nil, fmt.Errorf("keystore %s corrupted", path)
+	}
+	plain, err := crypts.DecCJSAES(cipherText, ks.cipherKey())
+	if err != nil || json.Unmarshal([]byte(plain), &ks.values) != nil {
+		return nil, fmt.Errorf("keystore %s can not be decrypted, wrong master key?", path)
+	}
+	return ks, nil
+}
+
+// cipherKey AES-256 key derived from master key of any length.
+func (ks *Keystore) cipherKey() string {
+	return string(crypts.DeriveKeyHexSHA256(ks.masterKey, 32))
+}
+
+func (ks *Keystore) Get(key string) (string, error) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	if value, ok := ks.values[key]; ok {
+		return value, nil
+	}
+	return "", ErrNotFound
+}
+
+// Set secret `key`, kept in memory until Save.
+func (ks *Keystore) Set(key, value string) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.values[key] = value
+}
+
+// Delete secret `key`, kept in memory until Save.
+func (ks *Keystore) Delete(key string) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	delete(ks.values, key)
+}
+
+// Keys of secret on keystore, sorted.
+func (ks *Keystore) Keys() []string {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	keys := make([]string, 0, len(ks.values))
+	for k := range ks.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Save encrypt and write keystore, readable by owner only.
+func (ks *Keystore) Save() error {
+	ks.mu.RLock()
+	plain, err := json.Marshal(ks.values)
+	ks.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+	cipherText, err := crypts.EncCJSAES(string(plain), ks.cipherKey())
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(ks.path), filepath.Base(ks.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.WriteString(cipherText + "\n"); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), ks.path)
+}
@@ -0,0 +1,62 @@
+package secrets
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// leaked SHA-256 of credentials once committed inline on `configs/config.yaml`, whitespace removed.
+// Published with repository history, never accepted again whatever provider they come from.
+var leaked = map[string]string{
+	"b3a5f180fbc12fb849a7a895da023f4e7bc7e44e15f504afb4b9b87a1852f5be": "jwt.key",
+	"e6d01a41fd6e7b653dc1ff07065f6ddd6a603f1bae1763352e9ff79a0dcf06c8": "gen_bearer_token.client_secret",
+	"7f52ee479be074ea4c33858c2f754e5fb69d506545f89afb6fb7536da07eba5d": "gen_commarch_token.client_secret",
+	"69cb21f5a066f8c2ff625baa627a2e8d70c9f004bf6b8c6915e3d7d3f418ae98": "gen_selfcare_bearer_token.client_secret",
+	"f8a9f8fec0f2af2a45bdf075a4ee510ce0fb88c64f72cbdfbdd64e1af588401d": "gen_identity_token.client_secret",
+	"b5bdbba85f0e5c144bc560430e3debd844d59d2d9d1afbd77a9e2db20836c233": "gen_bss_bearer_token.basic_token",
+	"08e368b2d11660e5624ed66c476c7f3872323d42e799acced250e81d479685cd": "gen_new_comm_bearer_token.client_secret",
+	"b03ddf3ca2e714a6548e7495e2a03f5e824eaac9837cd7f159c67b90fb4b7342": "gen_ciam_token.client_secret",
+	"5759d09cdcdfd8ed109527725fc16d70b3672c81450cd603b445f62fce305bd2": "cms_token.bearer_token",
+	"c4ce4ef60911fa6f96ac4e742ddf9dca8af9c3440aea3c5bb7cebbb0d4d49d79": "gen_cms_bearer_token.password",
+	"15e2b0d3c33891ebb0f1ef609ec419420c20e320ce94c65fbc8c3312448eb225": "gen_cms_bearer_token.client_secret",
+}
+
+// Leaked check whether `value` is a leaked credential, compared whitespace removed so reformatted PEM still caught.
+func Leaked(value string) bool {
+	sum := sha256.Sum256([]byte(strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, value)))
+	_, ok := leaked[hex.EncodeToString(sum[:])]
+	return ok
+}
+
+// CheckLeaked error naming every key of `section` holding leaked credential, eg: `secrets` entry after resolved.
+func CheckLeaked(section map[string]interface{}) error {
+	keys := []string{}
+	var check func(prefix string, node map[string]interface{})
+	check = func(prefix string, node map[string]interface{}) {
+		for k, v := range node {
+			switch n := v.(type) {
+			case map[string]interface{}:
+				check(prefix+k+".", n)
+			case string:
+				if Leaked(n) {
+					keys = append(keys, prefix+k)
+				}
+			}
+		}
+	}
+	check("", section)
+	if len(keys) == 0 {
+		return nil
+	}
+	sort.Strings(keys)
+	return fmt.Errorf("secret %s leaked, rotate it", strings.Join(keys, ", "))
+}
@@ -0,0 +1,52 @@
+package secrets
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Masked shown in place of secret.
+const Masked = "******"
+
+// MinMaskLength value shorter than it never registered, masking it would garble every output.
+const MinMaskLength = 4
+
+var registry = struct {
+	mu       sync.RWMutex
+	values   map[string]bool
+	replacer *strings.Replacer
+}{values: map[string]bool{}}
+
+// Register `values` masked by Mask from now on.
+func Register(values ...string) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	for _, v := range values {
+		if len(v) >= MinMaskLength {
+			registry.values[v] = true
+		}
+	}
+
+	// longest first so secret containing another one masked whole
+	sorted := make([]string, 0, len(registry.values))
+	for v := range registry.values {
+		sorted = append(sorted, v)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return len(sorted[i]) > len(sorted[j]) })
+	pairs := make([]string, 0, 2*len(sorted))
+	for _, v := range sorted {
+		pairs = append(pairs, v, Masked)
+	}
+	registry.replacer = strings.NewReplacer(pairs...)
+}
+
+// Mask `s` with every registered secret replaced by Masked.
+func Mask(s string) string {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	if registry.replacer == nil {
+		return s
+	}
+	return registry.replacer.Replace(s)
+}
@@ -0,0 +1,110 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// reference `${secret:<key>}` on config string value.
+var reference = regexp.MustCompile(`\$\{secret:([A-Za-z0-9_.\-]+)\}`)
+
+// Resolve replace every reference on string value of `config` by secret of `provider`, resolved secret registered
+// for masking. Every secret not found reported together.
+func Resolve(config map[string]map[string]interface{}, provider Provider) error {
+	problems := map[string]bool{}
+	resolve := func(s string) string {
+		return reference.ReplaceAllStringFunc(s, func(ref string) string {
+			key := reference.FindStringSubmatch(ref)[1]
+			value, err := provider.Get(key)
+			if err != nil {
+				if errors.Is(err, ErrNotFound) {
+					problems[fmt.Sprintf("secret %s not found on any provider", key)] = true
+				} else {
+					problems[fmt.Sprintf("secret %s: %v", key, err)] = true
+				}
+				return ref
+			}
+			Register(value)
+			return value
+		})
+	}
+	for _, section := range config {
+		walk(section, resolve)
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(problems))
+	for msg := range problems {
+		msgs = append(msgs, msg)
+	}
+	sort.Strings(msgs)
+	return errors.New(strings.Join(msgs, "; "))
+}
+
+// RegisterSection register every string value of `section` for masking, eg: secret still written inline.
+func RegisterSection(section map[string]interface{}) {
+	walk(section, func(s string) string {
+		Register(s)
+		return s
+	})
+}
+
+// walk replace every string value of `node` by `fn` of it, node being map or list.
+func walk(node interface{}, fn func(string) string) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for k, v := range n {
+			if s, ok := v.(string); ok {
+				n[k] = fn(s)
+				continue
+			}
+			walk(v, fn)
+		}
+	case []interface{}:
+		for i, v := range n {
+			if s, ok := v.(string); ok {
+				n[i] = fn(s)
+				continue
+			}
+			walk(v, fn)
+		}
+	}
+}
+
+// MaskConfig copy of `config` safe to print, registered secret masked and every value of `secrets` entry hidden.
+func MaskConfig(config map[string]map[string]interface{}) map[string]map[string]interface{} {
+	masked := make(map[string]map[string]interface{}, len(config))
+	for k, section := range config {
+		copied, _ := deepCopy(section).(map[string]interface{})
+		if k == "secrets" {
+			walk(copied, func(string) string { return Masked })
+		} else {
+			walk(copied, Mask)
+		}
+		masked[k] = copied
+	}
+	return masked
+}
+
+func deepCopy(node interface{}) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(n))
+		for k, v := range n {
+			copied[k] = deepCopy(v)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(n))
+		for i, v := range n {
+			copied[i] = deepCopy(v)
+		}
+		return copied
+	}
+	return node
+}
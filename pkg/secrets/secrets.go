@@ -0,0 +1,128 @@
+// Package secrets resolve `${secret:<key>}` reference of config from providers, value resolved masked on output.
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Provider name listed on `secret_store.providers`.
+const (
+	// ProviderEnv secret from environment variable, see EnvName.
+	ProviderEnv = "env"
+	// ProviderFile secret from mounted file, one file per key.
+	ProviderFile = "file"
+	// ProviderKeystore secret from local keystore encrypted by master key.
+	ProviderKeystore = "keystore"
+)
+
+const (
+	// EnvPrefix prefix of environment variable holding secret, eg: `SECRET_JWT_KEY` hold `jwt.key`.
+	EnvPrefix = "SECRET_"
+	// EnvKeystoreKey environment variable holding master key of keystore.
+	EnvKeystoreKey = "PSM_KEYSTORE_KEY"
+)
+
+// ErrNotFound returned by provider not having key.
+var ErrNotFound = errors.New("secret not found")
+
+// Provider source of secret.
+type Provider interface {
+	// Get secret `key`, ErrNotFound when provider not having it.
+	Get(key string) (string, error)
+}
+
+// EnvName environment variable of secret `key`, eg: `SECRET_JWT_KEY` of `jwt.key`.
+func EnvName(key string) string {
+	return EnvPrefix + strings.ToUpper(strings.NewReplacer(".", "_", "-", "_", "/", "_").Replace(key))
+}
+
+type envProvider struct {
+	lookup func(string) (string, bool)
+}
+
+// NewEnvProvider provider reading secret from environment variable named by EnvName, `lookup` default os.LookupEnv.
+func NewEnvProvider(lookup func(string) (string, bool)) Provider {
+	if lookup == nil {
+		lookup = os.LookupEnv
+	}
+	return &envProvider{lookup: lookup}
+}
+
+func (p *envProvider) Get(key string) (string, error) {
+	if value, ok := p.lookup(EnvName(key)); ok {
+		return value, nil
+	}
+	return "", ErrNotFound
+}
+
+type fileProvider struct {
+	dir string
+}
+
+// NewFileProvider provider reading secret from file named by key under `dir`, eg: `/run/secrets/jwt.key`,
+// how orchestrator mount secret. Trailing newline trimmed.
+func NewFileProvider(dir string) Provider {
+	return &fileProvider{dir: dir}
+}
+
+func (p *fileProvider) Get(key string) (string, error) {
+	if key == "" || strings.ContainsAny(key, `/\`) || strings.Contains(key, "..") {
+		return "", fmt.Errorf("invalid secret key %q", key)
+	}
+	buff, err := os.ReadFile(filepath.Join(p.dir, key))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(buff), "\r\n"), nil
+}
+
+// Chain provider trying every provider in order, first having key win.
+type Chain []Provider
+
+func (c Chain) Get(key string) (string, error) {
+	for _, p := range c {
+		value, err := p.Get(key)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		return value, err
+	}
+	return "", ErrNotFound
+}
+
+// New chain of providers `names` in order, file provider reading `dir`, keystore provider opening `keystore`
+// with master key of EnvKeystoreKey found by `lookup`.
+func New(names []string, dir, keystore string, lookup func(string) (string, bool)) (Provider, error) {
+	if lookup == nil {
+		lookup = os.LookupEnv
+	}
+	chain := Chain{}
+	for _, name := range names {
+		switch name {
+		case ProviderEnv:
+			chain = append(chain, NewEnvProvider(lookup))
+		case ProviderFile:
+			chain = append(chain, NewFileProvider(dir))
+		case ProviderKeystore:
+			masterKey, _ := lookup(EnvKeystoreKey)
+			if masterKey == "" {
+				return nil, fmt.Errorf("keystore provider need master key on %s", EnvKeystoreKey)
+			}
+			ks, err := OpenKeystore(keystore, masterKey)
+			if err != nil {
+				return nil, err
+			}
+			chain = append(chain, ks)
+		default:
+			return nil, fmt.Errorf("unknown secret provider %s", name)
+		}
+	}
+	return chain, nil
+}
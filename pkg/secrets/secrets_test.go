@@ -0,0 +1,125 @@
+package secrets
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SecretsSuite struct {
+	suite.Suite
+	dir string
+}
+
+func (ss *SecretsSuite) SetupTest() {
+	ss.dir = ss.T().TempDir()
+}
+
+func (ss *SecretsSuite) lookup(env map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		v, ok := env[name]
+		return v, ok
+	}
+}
+
+func (ss *SecretsSuite) TestEnvAndFileChain() {
+	ss.Require().NoError(os.WriteFile(filepath.Join(ss.dir, "jwt.key"), []byte("from-file\n"), 0600))
+	ss.Require().NoError(os.WriteFile(filepath.Join(ss.dir, "db.password"), []byte("db-pass-file\n"), 0600))
+
+	p, err := New([]string{ProviderEnv, ProviderFile}, ss.dir, "", ss.lookup(map[string]string{"SECRET_JWT_KEY": "from-env"}))
+	ss.Require().NoError(err)
+
+	v, err := p.Get("jwt.key")
+	ss.NoError(err)
+	ss.Equal("from-env", v, "first provider having key win")
+
+	v, err = p.Get("db.password")
+	ss.NoError(err)
+	ss.Equal("db-pass-file", v, "trailing newline of mounted file trimmed")
+
+	_, err = p.Get("missing")
+	ss.ErrorIs(err, ErrNotFound)
+
+	_, err = NewFileProvider(ss.dir).Get("../etc/passwd")
+	ss.Error(err, "key never escape secret directory")
+
+	_, err = New([]string{"vault"}, "", "", nil)
+	ss.EqualError(err, "unknown secret provider vault")
+}
+
+func (ss *SecretsSuite) TestKeystore() {
+	path := filepath.Join(ss.dir, "secrets.keystore")
+	ks, err := OpenKeystore(path, "master-key")
+	ss.Require().NoError(err, "keystore not created yet opened empty")
+	ks.Set("jwt.key", "keystore-value")
+	ks.Set("other", "x")
+	ks.Delete("other")
+	ss.Require().NoError(ks.Save())
+
+	buff, err := os.ReadFile(path)
+	ss.Require().NoError(err)
+	ss.NotContains(string(buff), "keystore-value", "stored encrypted")
+	info, err := os.Stat(path)
+	ss.Require().NoError(err)
+	ss.Equal(os.FileMode(0600), info.Mode().Perm())
+
+	p, err := New([]string{ProviderKeystore}, "", path, ss.lookup(map[string]string{EnvKeystoreKey: "master-key"}))
+	ss.Require().NoError(err)
+	v, err := p.Get("jwt.key")
+	ss.NoError(err)
+	ss.Equal("keystore-value", v)
+
+	_, err = OpenKeystore(path, "wrong-master-key")
+	ss.Error(err)
+	_, err = New([]string{ProviderKeystore}, "", path, ss.lookup(map[string]string{}))
+	ss.EqualError(err, "keystore provider need master key on PSM_KEYSTORE_KEY")
+
+	ss.Require().NoError(os.WriteFile(path, []byte("bm90IGEga2V5c3RvcmU="), 0600))
+	_, err = OpenKeystore(path, "master-key")
+	ss.Error(err, "corrupted keystore rejected without panic")
+}
+
+func (ss *SecretsSuite) TestResolveAndMask() {
+	config := map[string]map[string]interface{}{
+		"secrets": {"jwt": map[string]interface{}{"key": "${secret:jwt.key}"}},
+		"gorm":    {"connectionstring": "postgres://app:${secret:db.password}@db/parking"},
+		"cache":   {"addrs": []interface{}{"${secret:cache.addr}"}},
+	}
+	p := NewEnvProvider(ss.lookup(map[string]string{
+		"SECRET_JWT_KEY":     "jwt-secret-value",
+		"SECRET_DB_PASSWORD": "db-secret-value",
+	}))
+
+	err := Resolve(config, p)
+	ss.EqualError(err, "secret cache.addr not found on any provider", "every missing secret reported")
+	ss.Equal("jwt-secret-value", config["secrets"]["jwt"].(map[string]interface{})["key"])
+	ss.Equal("postgres://app:db-secret-value@db/parking", config["gorm"]["connectionstring"], "reference inside value")
+
+	ss.Equal("dsn postgres://app:******@db/parking", Mask("dsn postgres://app:db-secret-value@db/parking"))
+	ss.Equal("abc", Mask("abc"))
+
+	masked := MaskConfig(config)
+	ss.Equal(Masked, masked["secrets"]["jwt"].(map[string]interface{})["key"])
+	ss.Equal("postgres://app:******@db/parking", masked["gorm"]["connectionstring"])
+	ss.Equal("postgres://app:db-secret-value@db/parking", config["gorm"]["connectionstring"], "config itself untouched")
+}
+
+func (ss *SecretsSuite) TestLeaked() {
+	// once committed inline on config, published with repository history
+	ss.True(Leaked("P@ssw0rd"))
+	ss.True(Leaked(" P@ss\nw0rd \n"), "whitespace ignored, eg: reformatted PEM")
+	ss.False(Leaked("rotated-secret-value"))
+
+	err := CheckLeaked(map[string]interface{}{
+		"jwt":            map[string]interface{}{"key": "rotated-secret-value"},
+		"gen_ciam_token": map[string]interface{}{"client_id": "app", "client_secret": "P@ssw0rd"},
+	})
+	ss.EqualError(err, "secret gen_ciam_token.client_secret leaked, rotate it")
+	ss.NoError(CheckLeaked(map[string]interface{}{"jwt": map[string]interface{}{"key": "rotated-secret-value"}}))
+}
+
+func TestSecretsSuite(t *testing.T) {
+	suite.Run(t, new(SecretsSuite))
+}
@@ -0,0 +1,226 @@
+package tracing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+const (
+	// ExporterNone drop every span.
+	ExporterNone = "none"
+	// ExporterStdout write span as json line to stdout.
+	ExporterStdout = "stdout"
+	// ExporterOTLPFile append span as OTLP/JSON line to file, readable by OpenTelemetry collector `otlpjsonfile` receiver.
+	ExporterOTLPFile = "otlp_file"
+)
+
+// Exporter destination of ended span.
+type Exporter interface {
+	Export(service string, span SpanData) error
+	Shutdown() error
+}
+
+// NoopExporter exporter dropping every span.
+type NoopExporter struct{}
+
+// Export drop span.
+func (NoopExporter) Export(string, SpanData) error { return nil }
+
+// Shutdown nothing to close.
+func (NoopExporter) Shutdown() error { return nil }
+
+// writerExporter write one encoded span per line to writer.
+type writerExporter struct {
+	mu     sync.Mutex
+	w      io.Writer
+	encode func(service string, span SpanData) interface{}
+	close  func() error
+}
+
+func (e *writerExporter) Export(service string, span SpanData) error {
+	buf, err := json.Marshal(e.encode(service, span))
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	_, err = e.w.Write(append(buf, '\n'))
+	return err
+}
+
+func (e *writerExporter) Shutdown() error {
+	if e.close == nil {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.close()
+}
+
+// NewStdoutExporter exporter writing span with its service as json line to `w`, stdout when nil.
+func NewStdoutExporter(w io.Writer) Exporter {
+	if w == nil {
+		w = os.Stdout
+	}
+	return &writerExporter{w: w, encode: func(service string, span SpanData) interface{} {
+		return struct {
+			Service string `json:"service"`
+			SpanData
+		}{service, span}
+	}}
+}
+
+// NewOTLPFileExporter exporter appending span as OTLP/JSON `ExportTraceServiceRequest` line to file on `path`.
+func NewOTLPFileExporter(path string) (Exporter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return NewOTLPExporter(f, f.Close), nil
+}
+
+// NewOTLPExporter exporter writing span as OTLP/JSON line to `w`, `close` called on shutdown when not nil.
+func NewOTLPExporter(w io.Writer, close func() error) Exporter {
+	return &writerExporter{w: w, encode: otlpRequest, close: close}
+}
+
+// Config `tracing` entry.
+type Config struct {
+	Exporter string `mapstructure:"exporter" validate:"oneof=none stdout otlp_file"`
+	// Path file of otlp_file exporter.
+	Path        string `mapstructure:"path" validate:"required_if=Exporter otlp_file"`
+	ServiceName string `mapstructure:"service_name"`
+}
+
+// NewExporter exporter configured by `conf`, none when no exporter set.
+func NewExporter(conf Config) (Exporter, error) {
+	switch conf.Exporter {
+	case "", ExporterNone:
+		return NoopExporter{}, nil
+	case ExporterStdout:
+		return NewStdoutExporter(nil), nil
+	case ExporterOTLPFile:
+		if conf.Path == "" {
+			return nil, fmt.Errorf("tracing exporter %s need path", conf.Exporter)
+		}
+		return NewOTLPFileExporter(conf.Path)
+	}
+	return nil, fmt.Errorf("unknown tracing exporter %s", conf.Exporter)
+}
+
+// ServiceName service name configured by `conf`, `fallback` when not configured.
+func ServiceName(conf Config, fallback string) string {
+	if conf.ServiceName != "" {
+		return conf.ServiceName
+	}
+	return fallback
+}
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+// otlpKinds OTLP `SpanKind` enum of span kind.
+var otlpKinds = map[string]int{
+	SpanKindInternal: 1,
+	SpanKindServer:   2,
+	SpanKindClient:   3,
+}
+
+// otlpStatusError OTLP `Status.StatusCode` of failed span.
+const otlpStatusError = 2
+
+// otlpRequest OTLP/JSON `ExportTraceServiceRequest` of single span.
+func otlpRequest(service string, span SpanData) interface{} {
+	s := otlpSpan{
+		TraceID:           span.TraceID,
+		SpanID:            span.SpanID,
+		ParentSpanID:      span.ParentSpanID,
+		Name:              span.Name,
+		Kind:              otlpKinds[span.Kind],
+		StartTimeUnixNano: strconv.FormatInt(span.StartTime.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.EndTime.UnixNano(), 10),
+		Attributes:        otlpAttributes(span.Attributes),
+	}
+	if span.StatusCode == StatusError {
+		s.Status = otlpStatus{Code: otlpStatusError, Message: span.StatusMessage}
+	}
+	return map[string]interface{}{
+		"resourceSpans": []interface{}{map[string]interface{}{
+			"resource": map[string]interface{}{
+				"attributes": otlpAttributes(map[string]interface{}{"service.name": service}),
+			},
+			"scopeSpans": []interface{}{map[string]interface{}{
+				"scope": map[string]string{"name": "github.com/mhaikalla/parking-service-management-library/pkg/tracing"},
+				"spans": []otlpSpan{s},
+			}},
+		}},
+	}
+}
+
+// otlpAttributes OTLP key value list of `attributes` sorted by key, unsupported value written as string.
+func otlpAttributes(attributes map[string]interface{}) []otlpAttribute {
+	keys := make([]string, 0, len(attributes))
+	for k := range attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	list := make([]otlpAttribute, 0, len(keys))
+	for _, k := range keys {
+		var v otlpValue
+		switch value := attributes[k].(type) {
+		case string:
+			v.StringValue = &value
+		case bool:
+			v.BoolValue = &value
+		case int:
+			i := strconv.Itoa(value)
+			v.IntValue = &i
+		case int64:
+			i := strconv.FormatInt(value, 10)
+			v.IntValue = &i
+		case float64:
+			v.DoubleValue = &value
+		default:
+			s := fmt.Sprint(value)
+			v.StringValue = &s
+		}
+		list = append(list, otlpAttribute{Key: k, Value: v})
+	}
+	return list
+}
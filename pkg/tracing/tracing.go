@@ -0,0 +1,329 @@
+// Package tracing spans of request, usecase and storage call propagated by W3C `traceparent` header.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HeaderTraceParent W3C trace context header carrying trace and parent span ID.
+const HeaderTraceParent = "traceparent"
+
+const (
+	// SpanKindInternal span of operation inside this service.
+	SpanKindInternal = "internal"
+	// SpanKindServer span of inbound request.
+	SpanKindServer = "server"
+	// SpanKindClient span of outbound request.
+	SpanKindClient = "client"
+)
+
+const (
+	// StatusUnset span ended without error recorded.
+	StatusUnset = "unset"
+	// StatusError span ended with error recorded.
+	StatusError = "error"
+)
+
+// ErrInvalidTraceParent returned when `traceparent` header malformed.
+var ErrInvalidTraceParent = errors.New("invalid traceparent")
+
+// TraceID identifier of trace shared by every span of request.
+type TraceID [16]byte
+
+// SpanID identifier of single span.
+type SpanID [8]byte
+
+// String lower hex of trace ID.
+func (id TraceID) String() string { return hex.EncodeToString(id[:]) }
+
+// IsValid trace ID not all zero.
+func (id TraceID) IsValid() bool { return id != TraceID{} }
+
+// String lower hex of span ID.
+func (id SpanID) String() string { return hex.EncodeToString(id[:]) }
+
+// IsValid span ID not all zero.
+func (id SpanID) IsValid() bool { return id != SpanID{} }
+
+// SpanContext part of span propagated to child and to other service.
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+}
+
+// IsValid both trace and span ID set.
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID.IsValid() && sc.SpanID.IsValid()
+}
+
+// TraceParent `traceparent` header value of span context.
+func (sc SpanContext) TraceParent() string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return fmt.Sprintf("00-%s-%s-%s", sc.TraceID, sc.SpanID, flags)
+}
+
+// ParseTraceParent parse `traceparent` header value `version-traceid-parentid-flags`.
+func ParseTraceParent(value string) (SpanContext, error) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" || parts[0] == "00" && len(parts) != 4 {
+		return SpanContext{}, ErrInvalidTraceParent
+	}
+	var sc SpanContext
+	if err := decodeHex(sc.TraceID[:], parts[1]); err != nil {
+		return SpanContext{}, err
+	}
+	if err := decodeHex(sc.SpanID[:], parts[2]); err != nil {
+		return SpanContext{}, err
+	}
+	var flags [1]byte
+	if err := decodeHex(flags[:], parts[3]); err != nil {
+		return SpanContext{}, err
+	}
+	if !sc.IsValid() {
+		return SpanContext{}, ErrInvalidTraceParent
+	}
+	sc.Sampled = flags[0]&1 == 1
+	return sc, nil
+}
+
+// decodeHex decode lower hex `s` filling exactly `dst`.
+func decodeHex(dst []byte, s string) error {
+	if len(s) != hex.EncodedLen(len(dst)) || strings.ToLower(s) != s {
+		return ErrInvalidTraceParent
+	}
+	if _, err := hex.Decode(dst, []byte(s)); err != nil {
+		return ErrInvalidTraceParent
+	}
+	return nil
+}
+
+// Span timed operation, exported when ended.
+type Span struct {
+	tracer *Tracer
+	parent SpanID
+	name   string
+	kind   string
+	start  time.Time
+	sc     SpanContext
+
+	mu         sync.Mutex
+	ended      bool
+	attributes map[string]interface{}
+	status     string
+	message    string
+}
+
+// SpanContext identifier of span.
+func (s *Span) SpanContext() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.sc
+}
+
+// SetAttribute set attribute `key` of span.
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attributes[key] = value
+}
+
+// RecordError mark span failed by `err`, nil error ignored.
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = StatusError
+	s.message = err.Error()
+}
+
+// End end span and export it when sampled, only first call counted.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	attributes := make(map[string]interface{}, len(s.attributes))
+	for k, v := range s.attributes {
+		attributes[k] = v
+	}
+	data := SpanData{
+		Name:          s.name,
+		Kind:          s.kind,
+		TraceID:       s.sc.TraceID.String(),
+		SpanID:        s.sc.SpanID.String(),
+		StartTime:     s.start,
+		EndTime:       time.Now(),
+		Attributes:    attributes,
+		StatusCode:    s.status,
+		StatusMessage: s.message,
+	}
+	if s.parent.IsValid() {
+		data.ParentSpanID = s.parent.String()
+	}
+	s.mu.Unlock()
+
+	if s.sc.Sampled {
+		s.tracer.export(data)
+	}
+}
+
+// SpanData ended span handed to exporter.
+type SpanData struct {
+	Name          string                 `json:"name"`
+	Kind          string                 `json:"kind"`
+	TraceID       string                 `json:"trace_id"`
+	SpanID        string                 `json:"span_id"`
+	ParentSpanID  string                 `json:"parent_span_id,omitempty"`
+	StartTime     time.Time              `json:"start_time"`
+	EndTime       time.Time              `json:"end_time"`
+	Attributes    map[string]interface{} `json:"attributes,omitempty"`
+	StatusCode    string                 `json:"status_code"`
+	StatusMessage string                 `json:"status_message,omitempty"`
+}
+
+// Tracer create span of service and hand ended one to exporter.
+type Tracer struct {
+	service  string
+	exporter Exporter
+}
+
+// NewTracer tracer of `service` exporting to `exporter`, span still created but dropped when exporter nil.
+func NewTracer(service string, exporter Exporter) *Tracer {
+	if exporter == nil {
+		exporter = NoopExporter{}
+	}
+	return &Tracer{service: service, exporter: exporter}
+}
+
+// Service name of traced service.
+func (t *Tracer) Service() string { return t.service }
+
+// Shutdown flush and close exporter.
+func (t *Tracer) Shutdown() error { return t.exporter.Shutdown() }
+
+func (t *Tracer) export(data SpanData) {
+	_ = t.exporter.Export(t.service, data)
+}
+
+// Start start span `name` child of span on `ctx`, or root span of new trace when none.
+func (t *Tracer) Start(ctx context.Context, name, kind string) (context.Context, *Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	parent := SpanContextFromContext(ctx)
+	span := &Span{
+		tracer:     t,
+		name:       name,
+		kind:       kind,
+		start:      time.Now(),
+		attributes: map[string]interface{}{},
+		status:     StatusUnset,
+	}
+	if parent.IsValid() {
+		span.sc = SpanContext{TraceID: parent.TraceID, Sampled: parent.Sampled}
+		span.parent = parent.SpanID
+	} else {
+		_, _ = rand.Read(span.sc.TraceID[:])
+		span.sc.Sampled = true
+	}
+	_, _ = rand.Read(span.sc.SpanID[:])
+	return ContextWithSpan(ctx, span), span
+}
+
+var (
+	defaultMu     sync.RWMutex
+	defaultTracer = NewTracer("", nil)
+)
+
+// SetDefault set tracer used by `Start`.
+func SetDefault(t *Tracer) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultTracer = t
+}
+
+// Default tracer used by `Start`, drop every span until set.
+func Default() *Tracer {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+	return defaultTracer
+}
+
+// Start start internal span `name` on default tracer.
+func Start(ctx context.Context, name string) (context.Context, *Span) {
+	return Default().Start(ctx, name, SpanKindInternal)
+}
+
+// StartKind start span `name` of `kind` on default tracer.
+func StartKind(ctx context.Context, name, kind string) (context.Context, *Span) {
+	return Default().Start(ctx, name, kind)
+}
+
+type spanKey struct{}
+
+type remoteKey struct{}
+
+// ContextWithSpan context carrying `span` as parent of next span.
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+// SpanFromContext span carried by `ctx`, nil when none.
+func SpanFromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+// SpanContextFromContext span context carried by `ctx`, from local span or extracted remote parent.
+func SpanContextFromContext(ctx context.Context) SpanContext {
+	if ctx == nil {
+		return SpanContext{}
+	}
+	if span := SpanFromContext(ctx); span != nil {
+		return span.sc
+	}
+	sc, _ := ctx.Value(remoteKey{}).(SpanContext)
+	return sc
+}
+
+// Inject set `traceparent` of span on `ctx` to `header`, nothing set when no span.
+func Inject(ctx context.Context, header http.Header) {
+	if sc := SpanContextFromContext(ctx); sc.IsValid() {
+		header.Set(HeaderTraceParent, sc.TraceParent())
+	}
+}
+
+// Extract context carrying remote parent from `traceparent` of `header`, `ctx` returned as is when missing or malformed.
+func Extract(ctx context.Context, header http.Header) context.Context {
+	sc, err := ParseTraceParent(header.Get(HeaderTraceParent))
+	if err != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, remoteKey{}, sc)
+}
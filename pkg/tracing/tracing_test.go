@@ -0,0 +1,133 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TracingSuite struct {
+	suite.Suite
+}
+
+func (ts *TracingSuite) TestParseTraceParent() {
+	sc, err := ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ts.NoError(err)
+	ts.Equal("4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID.String())
+	ts.Equal("00f067aa0ba902b7", sc.SpanID.String())
+	ts.True(sc.Sampled)
+	ts.Equal("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", sc.TraceParent())
+
+	for _, invalid := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+	} {
+		_, err := ParseTraceParent(invalid)
+		ts.ErrorIs(err, ErrInvalidTraceParent, invalid)
+	}
+}
+
+func (ts *TracingSuite) TestPropagation() {
+	out := &bytes.Buffer{}
+	tracer := NewTracer("parking", NewStdoutExporter(out))
+
+	inbound := http.Header{}
+	inbound.Set(HeaderTraceParent, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ctx, server := tracer.Start(Extract(context.Background(), inbound), "GET /floors", SpanKindServer)
+	childCtx, child := tracer.Start(ctx, "UsecaseFloor.GetFloors", SpanKindInternal)
+	child.RecordError(errors.New("table corrupt"))
+	child.End()
+	server.End()
+	server.End()
+
+	outbound := http.Header{}
+	Inject(childCtx, outbound)
+	ts.Equal(child.SpanContext().TraceParent(), outbound.Get(HeaderTraceParent))
+
+	var spans []SpanData
+	dec := json.NewDecoder(out)
+	for dec.More() {
+		var span SpanData
+		ts.NoError(dec.Decode(&span))
+		spans = append(spans, span)
+	}
+	ts.Len(spans, 2, "span exported once on end")
+	ts.Equal("UsecaseFloor.GetFloors", spans[0].Name)
+	ts.Equal(StatusError, spans[0].StatusCode)
+	ts.Equal(server.SpanContext().SpanID.String(), spans[0].ParentSpanID)
+	ts.Equal("00f067aa0ba902b7", spans[1].ParentSpanID, "server span child of remote parent")
+	for _, span := range spans {
+		ts.Equal("4bf92f3577b34da6a3ce929d0e0e4736", span.TraceID)
+	}
+}
+
+func (ts *TracingSuite) TestNotSampled() {
+	out := &bytes.Buffer{}
+	tracer := NewTracer("parking", NewStdoutExporter(out))
+
+	inbound := http.Header{}
+	inbound.Set(HeaderTraceParent, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+	_, span := tracer.Start(Extract(context.Background(), inbound), "GET /floors", SpanKindServer)
+	span.End()
+	ts.Empty(out.String(), "caller decided not to sample trace")
+
+	_, root := tracer.Start(context.Background(), "root", SpanKindInternal)
+	ts.True(root.SpanContext().IsValid())
+	ts.True(root.SpanContext().Sampled)
+}
+
+func (ts *TracingSuite) TestOTLPExporter() {
+	out := &bytes.Buffer{}
+	tracer := NewTracer("parking", NewOTLPExporter(out, nil))
+	_, span := tracer.Start(context.Background(), "file.LoadFile", SpanKindInternal)
+	span.SetAttribute("table", "floors")
+	span.SetAttribute("size", 10)
+	span.End()
+
+	var request struct {
+		ResourceSpans []struct {
+			Resource struct {
+				Attributes []otlpAttribute `json:"attributes"`
+			} `json:"resource"`
+			ScopeSpans []struct {
+				Spans []otlpSpan `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}
+	ts.NoError(json.Unmarshal(out.Bytes(), &request))
+	ts.Equal("service.name", request.ResourceSpans[0].Resource.Attributes[0].Key)
+	ts.Equal("parking", *request.ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+
+	exported := request.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	ts.Equal(span.SpanContext().TraceID.String(), exported.TraceID)
+	ts.Equal(1, exported.Kind)
+	ts.Equal("size", exported.Attributes[0].Key)
+	ts.Equal("10", *exported.Attributes[0].Value.IntValue)
+	ts.Equal("floors", *exported.Attributes[1].Value.StringValue)
+}
+
+func (ts *TracingSuite) TestNewExporter() {
+	exporter, err := NewExporter(Config{})
+	ts.NoError(err)
+	ts.IsType(NoopExporter{}, exporter)
+
+	_, err = NewExporter(Config{Exporter: ExporterOTLPFile})
+	ts.Error(err, "file exporter need path")
+
+	_, err = NewExporter(Config{Exporter: "jaeger"})
+	ts.Error(err)
+}
+
+func TestTracingSuite(t *testing.T) {
+	suite.Run(t, new(TracingSuite))
+}
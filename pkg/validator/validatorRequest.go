@@ -1,17 +1,60 @@
 package validator
 
 import (
+	"reflect"
 	"regexp"
+	"strings"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
 
 	validation "github.com/go-playground/validator/v10"
 )
 
 func NewValidator() validation.Validate {
-	validators := *validation.New()
+	// configured before copied, validate pool keep using original pointer
+	validators := validation.New()
 	validators.RegisterValidation("alpha_or_numeric", ValidateAlphaOrNumeric)
-	return validators
+	validators.RegisterTagNameFunc(JSONFieldName)
+	return *validators
 }
 
 func ValidateAlphaOrNumeric(fl validation.FieldLevel) bool {
 	return regexp.MustCompile(`^[a-zA-Z0-9]+$`).MatchString(fl.Field().String())
 }
+
+// JSONFieldName report field by its json name, so validation error point to field client sent.
+func JSONFieldName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
+
+// FieldErrors failed rules of `err` returned by `Validate.Struct` with message on `lang`.
+// Field is json path from request root, e.g. `lots[0].name`. Error other than validation errors give nil.
+func FieldErrors(err error, lang string) []errs.FieldError {
+	validationErrors, ok := err.(validation.ValidationErrors)
+	if !ok {
+		return nil
+	}
+	fields := make([]errs.FieldError, 0, len(validationErrors))
+	for _, fe := range validationErrors {
+		field := fe.Namespace()
+		if idx := strings.Index(field, "."); idx >= 0 {
+			field = field[idx+1:]
+		}
+		kind := fe.Kind()
+		length := kind == reflect.String || kind == reflect.Slice || kind == reflect.Array || kind == reflect.Map
+		fields = append(fields, errs.FieldError{
+			Field:   field,
+			Rule:    fe.Tag(),
+			Param:   fe.Param(),
+			Message: errs.FieldMessage(lang, field, fe.Tag(), fe.Param(), length),
+		})
+	}
+	return fields
+}
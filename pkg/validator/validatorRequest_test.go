@@ -0,0 +1,44 @@
+package validator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mhaikalla/parking-service-management-library/pkg/errs"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type lot struct {
+	Name string `json:"name" validate:"required,min=3"`
+	Code string `json:"code" validate:"alpha_or_numeric"`
+}
+
+type bulkLot struct {
+	Lots  []lot `json:"lots" validate:"required,dive"`
+	Limit int   `json:"limit" validate:"gte=0"`
+}
+
+type ValidatorSuite struct {
+	suite.Suite
+}
+
+func (vs *ValidatorSuite) TestFieldErrors() {
+	v := NewValidator()
+	err := v.Struct(bulkLot{Lots: []lot{{Name: "ab", Code: "A-1"}}, Limit: -1})
+	vs.Require().Error(err)
+
+	fields := FieldErrors(err, errs.LangEN)
+	vs.Equal([]errs.FieldError{
+		{Field: "lots[0].name", Rule: "min", Param: "3", Message: "lots[0].name must contain at least 3 characters/items"},
+		{Field: "lots[0].code", Rule: "alpha_or_numeric", Message: "lots[0].code may only contain letters and numbers"},
+		{Field: "limit", Rule: "gte", Param: "0", Message: "limit must be greater than or equal to 0"},
+	}, fields, "field must be reported by json path")
+
+	vs.Equal("lots wajib diisi", FieldErrors(v.Struct(bulkLot{}), errs.LangID)[0].Message)
+	vs.Nil(FieldErrors(errors.New("not validation"), errs.LangEN))
+}
+
+func TestValidatorSuite(t *testing.T) {
+	suite.Run(t, new(ValidatorSuite))
+}